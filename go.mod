@@ -1,6 +1,6 @@
 module github.com/mathieu-lemay/go-sandbox
 
-go 1.23.2
+go 1.24.0
 
 require (
 	github.com/go-playground/validator/v10 v10.23.0
@@ -0,0 +1,355 @@
+package betteriter
+
+import (
+	"cmp"
+	"context"
+	"io"
+	"math/rand/v2"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const benchSize = 1_000
+
+func benchValues() []int {
+	return sequence(benchSize)
+}
+
+func isEven(i int) bool {
+	return i%2 == 0
+}
+
+func double(i int) (int, error) {
+	return i * 2, nil
+}
+
+func drain[T any](b *testing.B, it Iterator[T]) {
+	b.Helper()
+
+	for _, err := range it.it {
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCollect(b *testing.B) {
+	values := benchValues()
+
+	b.ReportAllocs()
+
+	for range b.N {
+		_, _ = New(values).Collect()
+	}
+}
+
+func BenchmarkCollect_Generic(b *testing.B) {
+	b.ReportAllocs()
+
+	for range b.N {
+		_, _ = NewRepeatN(1, benchSize).Collect()
+	}
+}
+
+func BenchmarkRange(b *testing.B) {
+	values := benchValues()
+
+	b.ReportAllocs()
+
+	for range b.N {
+		drain(b, New(values))
+	}
+}
+
+func BenchmarkMap(b *testing.B) {
+	values := benchValues()
+
+	b.ReportAllocs()
+
+	for range b.N {
+		_, _ = Map(New(values), double).Collect()
+	}
+}
+
+func BenchmarkMap_Generic(b *testing.B) {
+	b.ReportAllocs()
+
+	for range b.N {
+		_, _ = Map(NewRepeatN(1, benchSize), double).Collect()
+	}
+}
+
+func BenchmarkFilter(b *testing.B) {
+	values := benchValues()
+
+	b.ReportAllocs()
+
+	for range b.N {
+		_, _ = Filter(New(values), isEven).Collect()
+	}
+}
+
+func BenchmarkFilter_Generic(b *testing.B) {
+	b.ReportAllocs()
+
+	for range b.N {
+		_, _ = Filter(NewRepeatN(2, benchSize), isEven).Collect()
+	}
+}
+
+func BenchmarkMapFilter(b *testing.B) {
+	values := benchValues()
+
+	b.ReportAllocs()
+
+	for range b.N {
+		_, _ = Sum(Map(Filter(New(values), isEven), double))
+	}
+}
+
+func BenchmarkMapFilter_Generic(b *testing.B) {
+	b.ReportAllocs()
+
+	for range b.N {
+		_, _ = Sum(Map(Filter(NewRepeatN(2, benchSize), isEven), double))
+	}
+}
+
+func BenchmarkZip(b *testing.B) {
+	values := benchValues()
+
+	b.ReportAllocs()
+
+	for range b.N {
+		drain(b, Zip(values, values))
+	}
+}
+
+func BenchmarkProduct(b *testing.B) {
+	values := sequence(32)
+
+	b.ReportAllocs()
+
+	for range b.N {
+		drain(b, Product(values, values))
+	}
+}
+
+func BenchmarkCombinations(b *testing.B) {
+	values := sequence(16)
+
+	b.ReportAllocs()
+
+	for range b.N {
+		drain(b, Combinations(values, 3))
+	}
+}
+
+func BenchmarkPermutations(b *testing.B) {
+	values := sequence(6)
+
+	b.ReportAllocs()
+
+	for range b.N {
+		drain(b, Permutations(values))
+	}
+}
+
+func BenchmarkPowerSet(b *testing.B) {
+	values := sequence(10)
+
+	b.ReportAllocs()
+
+	for range b.N {
+		drain(b, PowerSet(values))
+	}
+}
+
+func BenchmarkInterleave(b *testing.B) {
+	values := benchValues()
+
+	b.ReportAllocs()
+
+	for range b.N {
+		drain(b, Interleave(New(values), New(values)))
+	}
+}
+
+func BenchmarkRoundRobin(b *testing.B) {
+	values := benchValues()
+
+	b.ReportAllocs()
+
+	for range b.N {
+		drain(b, RoundRobin(New(values), New(values[:benchSize/2])))
+	}
+}
+
+func BenchmarkTee(b *testing.B) {
+	values := benchValues()
+
+	b.ReportAllocs()
+
+	for range b.N {
+		for _, it := range Tee(New(values), 2) {
+			drain(b, it)
+		}
+	}
+}
+
+func BenchmarkThrottle(b *testing.B) {
+	values := benchValues()
+
+	b.ReportAllocs()
+
+	for range b.N {
+		drain(b, Throttle(New(values), 1, benchSize, WithClock(newFakeClock())))
+	}
+}
+
+func BenchmarkDebounce(b *testing.B) {
+	values := benchValues()
+
+	b.ReportAllocs()
+
+	for range b.N {
+		drain(b, Debounce(New(values), time.Second, WithClock(newFakeClock())))
+	}
+}
+
+func BenchmarkMapRetry(b *testing.B) {
+	values := benchValues()
+	policy := RetryPolicy{
+		MaxAttempts: 3,
+		Backoff:     ConstantBackoff(time.Second),
+		Retryable:   nil,
+	}
+
+	b.ReportAllocs()
+
+	for range b.N {
+		drain(b, MapRetry(New(values), policy, double, WithClock(newFakeClock())))
+	}
+}
+
+func BenchmarkStats(b *testing.B) {
+	values := benchValues()
+
+	b.ReportAllocs()
+
+	for range b.N {
+		_, _ = CollectStats(New(values))
+	}
+}
+
+func BenchmarkQuantile(b *testing.B) {
+	values := benchValues()
+
+	b.ReportAllocs()
+
+	for range b.N {
+		_, _ = Quantile(New(values), 0.9)
+	}
+}
+
+func BenchmarkSample(b *testing.B) {
+	values := benchValues()
+	rng := rand.New(rand.NewPCG(1, 2))
+
+	b.ReportAllocs()
+
+	for range b.N {
+		_, _ = Sample(New(values), 10, rng)
+	}
+}
+
+func BenchmarkShuffle(b *testing.B) {
+	values := benchValues()
+	rng := rand.New(rand.NewPCG(1, 2))
+
+	b.ReportAllocs()
+
+	for range b.N {
+		drain(b, Shuffle(New(values), 100, rng))
+	}
+}
+
+func BenchmarkMemoize(b *testing.B) {
+	values := benchValues()
+
+	b.ReportAllocs()
+
+	for range b.N {
+		memo, stop := Memoize(New(values))
+
+		drain(b, memo)
+		drain(b, memo)
+		stop()
+	}
+}
+
+func BenchmarkCollectInto(b *testing.B) {
+	values := make([]string, benchSize)
+	for i := range values {
+		values[i] = strconv.Itoa(i)
+	}
+
+	b.ReportAllocs()
+
+	for range b.N {
+		_, _ = CollectInto(New(values), Join(","))
+	}
+}
+
+func BenchmarkCompare(b *testing.B) {
+	values := benchValues()
+
+	b.ReportAllocs()
+
+	for range b.N {
+		_, _ = Compare(New(values), New(values), cmp.Compare[int])
+	}
+}
+
+func BenchmarkDiff(b *testing.B) {
+	values := sequence(100)
+	other := append(sequence(50), values[60:]...)
+
+	b.ReportAllocs()
+
+	for range b.N {
+		drain(b, Diff(New(values), New(other)))
+	}
+}
+
+func BenchmarkTrace(b *testing.B) {
+	values := benchValues()
+
+	logger := log.Logger
+	log.Logger = zerolog.New(io.Discard).Level(zerolog.InfoLevel)
+
+	b.Cleanup(func() {
+		log.Logger = logger
+	})
+
+	b.ReportAllocs()
+
+	for range b.N {
+		it, _ := Trace(New(values), "bench")
+		drain(b, it)
+	}
+}
+
+func BenchmarkParallelReduce(b *testing.B) {
+	values := sequence(100 * benchSize)
+
+	b.ReportAllocs()
+
+	for range b.N {
+		_, _ = ParallelReduce(context.Background(), New(values), 0, 0, double, add)
+	}
+}
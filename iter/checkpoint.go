@@ -0,0 +1,157 @@
+package betteriter
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// OffsetStore persists how many elements of an iterator have been processed.
+type OffsetStore interface {
+	// Load returns the saved offset, or 0 if none was saved.
+	Load() (int, error)
+	// Save saves the offset.
+	Save(offset int) error
+}
+
+// FileOffsetStore is an OffsetStore backed by a file.
+type FileOffsetStore struct {
+	path string
+}
+
+// NewFileOffsetStore creates an OffsetStore saving the offset in the file at path.
+func NewFileOffsetStore(path string) *FileOffsetStore {
+	return &FileOffsetStore{
+		path: path,
+	}
+}
+
+// Load returns the offset saved in the file, or 0 if the file doesn't exist.
+func (s *FileOffsetStore) Load() (int, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to read offset: %w", err)
+	}
+
+	offset, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0, fmt.Errorf("invalid offset: %w", err)
+	}
+
+	return offset, nil
+}
+
+// Save writes the offset to a new temporary file next to the saved one, syncs it, then moves it over the saved file,
+// so a crash leaves either the old or the new offset. Every save uses its own temporary file, so stores sharing a path
+// don't overwrite each other's.
+func (s *FileOffsetStore) Save(offset int) error {
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write offset: %w", err)
+	}
+
+	if err := writeAndSync(tmp, strconv.Itoa(offset)+"\n"); err != nil {
+		_ = os.Remove(tmp.Name())
+
+		return fmt.Errorf("failed to write offset: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		_ = os.Remove(tmp.Name())
+
+		return fmt.Errorf("failed to write offset: %w", err)
+	}
+
+	return nil
+}
+
+// writeAndSync writes data to the file, flushes it to disk and closes it.
+func writeAndSync(f *os.File, data string) error {
+	_, err := f.WriteString(data)
+	if err == nil {
+		err = f.Sync()
+	}
+
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+// Checkpointed saves the number of elements processed by the consumer in store, every n elements and when the
+// iterator ends. An element counts as processed once the consumer asks for the next one, so an interrupted run can
+// process the last element again when it's resumed: processing is at least once. Failing to save the offset yields
+// an error and ends the iterator.
+func Checkpointed[T any](iterator Iterator[T], store OffsetStore, n int) Iterator[T] {
+	return checkpointed(iterator, store, n, 0)
+}
+
+// Resume is like Checkpointed, but first skips the elements already processed according to store. The source must be
+// deterministic, like New or FileLines, for the skipped elements to be the processed ones.
+func Resume[T any](iterator Iterator[T], store OffsetStore, n int) Iterator[T] {
+	inner := func(yield func(T, error) bool) {
+		offset, err := store.Load()
+		if err != nil {
+			var zero T
+			yield(zero, fmt.Errorf("failed to load offset: %w", err))
+
+			return
+		}
+
+		checkpointed(Skip(iterator, offset), store, n, offset).it(yield)
+	}
+
+	return Iterator[T]{
+		it: inner,
+	}
+}
+
+func checkpointed[T any](iterator Iterator[T], store OffsetStore, n int, base int) Iterator[T] {
+	n = max(n, 1)
+
+	inner := func(yield func(T, error) bool) {
+		offset := base
+
+		save := func() bool {
+			if err := store.Save(offset); err != nil {
+				var zero T
+				yield(zero, fmt.Errorf("failed to save offset: %w", err))
+
+				return false
+			}
+
+			return true
+		}
+
+		for v, err := range iterator.it {
+			if !yield(v, err) {
+				// The consumer is gone, there's no one left to report a failure to.
+				_ = store.Save(offset)
+
+				return
+			}
+
+			offset++
+
+			if (offset-base)%n == 0 && !save() {
+				return
+			}
+		}
+
+		save()
+	}
+
+	return Iterator[T]{
+		it:   inner,
+		size: iterator.size,
+	}
+}
@@ -0,0 +1,164 @@
+package betteriter
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryOffsetStore struct {
+	saved   []int
+	loadErr error
+	saveErr error
+}
+
+func (s *memoryOffsetStore) Load() (int, error) {
+	if len(s.saved) == 0 {
+		return 0, s.loadErr
+	}
+
+	return s.saved[len(s.saved)-1], s.loadErr
+}
+
+func (s *memoryOffsetStore) Save(offset int) error {
+	if s.saveErr != nil {
+		return s.saveErr
+	}
+
+	s.saved = append(s.saved, offset)
+
+	return nil
+}
+
+func TestFileOffsetStore_SavesAndLoadsTheOffset(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "offset")
+	store := NewFileOffsetStore(path)
+
+	offset, err := store.Load()
+	require.NoError(t, err)
+	assert.Equal(t, 0, offset)
+
+	require.NoError(t, store.Save(42))
+
+	offset, err = NewFileOffsetStore(path).Load()
+	require.NoError(t, err)
+	assert.Equal(t, 42, offset)
+
+	tmp, err := filepath.Glob(path + ".*.tmp")
+	require.NoError(t, err)
+	assert.Empty(t, tmp)
+}
+
+func TestFileOffsetStore_DoesNotOverwriteOtherTemporaryFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "offset")
+	other := path + ".tmp"
+
+	require.NoError(t, os.WriteFile(other, []byte("7\n"), 0o600))
+	require.NoError(t, NewFileOffsetStore(path).Save(42))
+
+	data, err := os.ReadFile(other)
+	require.NoError(t, err)
+	assert.Equal(t, "7\n", string(data))
+
+	offset, err := NewFileOffsetStore(path).Load()
+	require.NoError(t, err)
+	assert.Equal(t, 42, offset)
+}
+
+func TestFileOffsetStore_ReturnsErrors(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "offset")
+	require.NoError(t, os.WriteFile(path, []byte("not a number"), 0o600))
+
+	_, err := NewFileOffsetStore(path).Load()
+	require.ErrorContains(t, err, "invalid offset")
+
+	err = NewFileOffsetStore(filepath.Join(dir, "missing", "offset")).Save(1)
+	assert.ErrorContains(t, err, "failed to write offset")
+}
+
+func TestCheckpointed_SavesTheOffsetPeriodically(t *testing.T) {
+	store := &memoryOffsetStore{}
+
+	output, err := Checkpointed(New(sequence(7)), store, 3).Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, sequence(7), output)
+	assert.Equal(t, []int{3, 6, 7}, store.saved)
+}
+
+func TestCheckpointed_SavesTheOffsetWhenInterrupted(t *testing.T) {
+	store := &memoryOffsetStore{}
+
+	for v := range Checkpointed(New(sequence(10)), store, 3).it {
+		if v == 4 {
+			break
+		}
+	}
+
+	// The element the consumer stopped at is not counted as processed
+	assert.Equal(t, []int{3, 4}, store.saved)
+}
+
+func TestCheckpointed_ReturnsSaveErrors(t *testing.T) {
+	store := &memoryOffsetStore{saveErr: errors.New("disk full")}
+
+	output, err := Checkpointed(New(sequence(10)), store, 3).Collect()
+
+	assert.Empty(t, output)
+	assert.ErrorContains(t, err, "failed to save offset: disk full")
+}
+
+func TestResume_SkipsProcessedElements(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lines.txt")
+	data := ""
+
+	for i := range 10 {
+		data += strconv.Itoa(i) + "\n"
+	}
+
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+
+	store := NewFileOffsetStore(filepath.Join(t.TempDir(), "offset"))
+
+	// First run crashes while processing line 4
+	processed := make([]string, 0)
+
+	for v, err := range Resume(FileLines(path), store, 2).it {
+		require.NoError(t, err)
+
+		if v == "4" {
+			break
+		}
+
+		processed = append(processed, v)
+	}
+
+	// Second run picks up where the first one stopped
+	for v, err := range Resume(FileLines(path), store, 2).it {
+		require.NoError(t, err)
+
+		processed = append(processed, v)
+	}
+
+	assert.Equal(t, []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"}, processed)
+
+	offset, err := store.Load()
+	require.NoError(t, err)
+	assert.Equal(t, 10, offset)
+}
+
+func TestResume_ReturnsLoadErrors(t *testing.T) {
+	store := &memoryOffsetStore{loadErr: errors.New("corrupted")}
+
+	output, err := Resume(New(sequence(10)), store, 3).Collect()
+
+	assert.Empty(t, output)
+	assert.ErrorContains(t, err, "failed to load offset: corrupted")
+}
@@ -0,0 +1,46 @@
+package betteriter
+
+import "time"
+
+// Clock is the source of time used by the time-based combinators.
+type Clock interface {
+	Now() time.Time
+	Sleep(d time.Duration)
+}
+
+// TimingOptions is the configuration of the time-based combinators.
+type TimingOptions struct {
+	Clock Clock
+}
+
+// TimingConfig is a function to change the configuration of a time-based combinator.
+type TimingConfig func(*TimingOptions)
+
+// WithClock changes the clock, mostly useful to make tests run instantly.
+func WithClock(c Clock) TimingConfig {
+	return func(o *TimingOptions) {
+		o.Clock = c
+	}
+}
+
+func newTimingOptions(opts []TimingConfig) TimingOptions {
+	options := TimingOptions{
+		Clock: systemClock{},
+	}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return options
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
@@ -0,0 +1,55 @@
+package betteriter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct {
+	now   time.Time
+	slept []time.Duration
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{
+		now:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		slept: nil,
+	}
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Sleep(d time.Duration) {
+	c.slept = append(c.slept, d)
+	c.now = c.now.Add(d)
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+// advancing returns an iterator over values that advances the clock by the matching delay before yielding each value.
+func advancing[T any](clock *fakeClock, values []T, delays []time.Duration) Iterator[T] {
+	idx := 0
+
+	return Map(New(values), func(v T) (T, error) {
+		clock.Advance(delays[idx])
+		idx += 1
+
+		return v, nil
+	})
+}
+
+func TestNewTimingOptions_UsesTheSystemClockByDefault(t *testing.T) {
+	assert.Equal(t, systemClock{}, newTimingOptions(nil).Clock)
+}
+
+func TestWithClock_ChangesTheClock(t *testing.T) {
+	clock := newFakeClock()
+
+	assert.Same(t, clock, newTimingOptions([]TimingConfig{WithClock(clock)}).Clock)
+}
@@ -0,0 +1,173 @@
+package betteriter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Collector accumulates the elements of an iterator into a result of type R.
+type Collector[T any, R any] interface {
+	// Add adds an element to the collector. Returning an error stops the collection.
+	Add(v T) error
+	// Result returns what has been collected so far.
+	Result() R
+}
+
+// CollectInto consumes the iterator into the collector and returns its result.
+func CollectInto[T any, R any](iterator Iterator[T], collector Collector[T, R]) (R, error) {
+	for v, err := range iterator.it {
+		if err == nil {
+			err = collector.Add(v)
+		}
+
+		if err != nil {
+			var zero R
+
+			return zero, err
+		}
+	}
+
+	return collector.Result(), nil
+}
+
+// DuplicateKeyPolicy defines how ToMap handles keys that are collected more than once.
+type DuplicateKeyPolicy int
+
+const (
+	// KeepFirst keeps the first value collected for a key.
+	KeepFirst DuplicateKeyPolicy = iota
+	// KeepLast keeps the last value collected for a key.
+	KeepLast
+	// FailOnDuplicate returns an error when a key is collected more than once.
+	FailOnDuplicate
+)
+
+// ToSlice collects elements into a slice, preallocated for capacity elements.
+func ToSlice[T any](capacity int) Collector[T, []T] {
+	return &sliceCollector[T]{
+		values: make([]T, 0, max(capacity, 0)),
+	}
+}
+
+// ToSet collects elements into a set.
+func ToSet[T comparable]() Collector[T, map[T]struct{}] {
+	return &setCollector[T]{
+		values: make(map[T]struct{}),
+	}
+}
+
+// ToMap collects key-value tuples into a map, handling duplicate keys according to policy.
+func ToMap[K comparable, V any](policy DuplicateKeyPolicy) Collector[Tuple[K, V], map[K]V] {
+	return &mapCollector[K, V]{
+		policy: policy,
+		values: make(map[K]V),
+	}
+}
+
+// Join concatenates strings, separated by sep.
+func Join(sep string) Collector[string, string] {
+	return &joinCollector{
+		sep:     sep,
+		builder: strings.Builder{},
+		empty:   true,
+	}
+}
+
+// ToChannel sends elements to ch and returns how many were sent. The channel is not closed once the collection is
+// done.
+func ToChannel[T any](ch chan<- T) Collector[T, int] {
+	return &channelCollector[T]{
+		ch:    ch,
+		count: 0,
+	}
+}
+
+type sliceCollector[T any] struct {
+	values []T
+}
+
+func (c *sliceCollector[T]) Add(v T) error {
+	c.values = append(c.values, v)
+
+	return nil
+}
+
+func (c *sliceCollector[T]) Result() []T {
+	return c.values
+}
+
+type setCollector[T comparable] struct {
+	values map[T]struct{}
+}
+
+func (c *setCollector[T]) Add(v T) error {
+	c.values[v] = struct{}{}
+
+	return nil
+}
+
+func (c *setCollector[T]) Result() map[T]struct{} {
+	return c.values
+}
+
+type mapCollector[K comparable, V any] struct {
+	policy DuplicateKeyPolicy
+	values map[K]V
+}
+
+func (c *mapCollector[K, V]) Add(v Tuple[K, V]) error {
+	if _, exists := c.values[v.A]; exists {
+		switch c.policy {
+		case KeepFirst:
+			return nil
+		case KeepLast:
+		case FailOnDuplicate:
+			return fmt.Errorf("duplicate key: %v", v.A)
+		}
+	}
+
+	c.values[v.A] = v.B
+
+	return nil
+}
+
+func (c *mapCollector[K, V]) Result() map[K]V {
+	return c.values
+}
+
+type joinCollector struct {
+	sep     string
+	builder strings.Builder
+	empty   bool
+}
+
+func (c *joinCollector) Add(v string) error {
+	if !c.empty {
+		c.builder.WriteString(c.sep)
+	}
+
+	c.builder.WriteString(v)
+	c.empty = false
+
+	return nil
+}
+
+func (c *joinCollector) Result() string {
+	return c.builder.String()
+}
+
+type channelCollector[T any] struct {
+	ch    chan<- T
+	count int
+}
+
+func (c *channelCollector[T]) Add(v T) error {
+	c.ch <- v
+	c.count++
+
+	return nil
+}
+
+func (c *channelCollector[T]) Result() int {
+	return c.count
+}
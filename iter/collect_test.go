@@ -0,0 +1,102 @@
+package betteriter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectInto_ReturnsErrors(t *testing.T) {
+	output, err := CollectInto(failing([]int{1}), ToSlice[int](1))
+
+	assert.Nil(t, output)
+	assert.ErrorContains(t, err, "Invalid value")
+}
+
+func TestToSlice_CollectsIntoAPreallocatedSlice(t *testing.T) {
+	output, err := CollectInto(New([]int{1, 2, 3}), ToSlice[int](10))
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, output)
+	assert.Equal(t, 10, cap(output))
+}
+
+func TestToSet_CollectsUniqueElements(t *testing.T) {
+	output, err := CollectInto(New([]string{"a", "b", "a", "c"}), ToSet[string]())
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]struct{}{"a": {}, "b": {}, "c": {}}, output)
+}
+
+func TestToMap_HandlesDuplicateKeys(t *testing.T) {
+	values := []Tuple[string, int]{
+		{"a", 1},
+		{"b", 2},
+		{"a", 3},
+	}
+
+	testCases := []struct {
+		msg      string
+		policy   DuplicateKeyPolicy
+		expected map[string]int
+	}{
+		{"keep first", KeepFirst, map[string]int{"a": 1, "b": 2}},
+		{"keep last", KeepLast, map[string]int{"a": 3, "b": 2}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			output, err := CollectInto(New(values), ToMap[string, int](tc.policy))
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, output)
+		})
+	}
+
+	t.Run("fail on duplicate", func(t *testing.T) {
+		output, err := CollectInto(New(values), ToMap[string, int](FailOnDuplicate))
+
+		assert.Nil(t, output)
+		assert.ErrorContains(t, err, "duplicate key: a")
+	})
+}
+
+func TestJoin_ConcatenatesStrings(t *testing.T) {
+	testCases := []struct {
+		msg      string
+		values   []string
+		expected string
+	}{
+		{"empty", []string{}, ""},
+		{"single", []string{"a"}, "a"},
+		{"multiple", []string{"a", "b", "c"}, "a, b, c"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			output, err := CollectInto(New(tc.values), Join(", "))
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, output)
+		})
+	}
+}
+
+func TestToChannel_SendsElements(t *testing.T) {
+	ch := make(chan int, 3)
+
+	count, err := CollectInto(New([]int{1, 2, 3}), ToChannel(ch))
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	close(ch)
+
+	output := make([]int, 0)
+	for v := range ch {
+		output = append(output, v)
+	}
+
+	assert.Equal(t, []int{1, 2, 3}, output)
+}
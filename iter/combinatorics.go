@@ -0,0 +1,220 @@
+package betteriter
+
+// Product returns the cartesian product of the given pools, in lexicographic order of the pools' indices. Each
+// yielded slice is freshly allocated and can be retained by the caller. Use ProductOf for iterators.
+func Product[T any](pools ...[]T) Iterator[[]T] {
+	return Iterator[[]T]{
+		it: func(yield func([]T, error) bool) {
+			for _, p := range pools {
+				if len(p) == 0 {
+					return
+				}
+			}
+
+			indices := make([]int, len(pools))
+
+			for {
+				out := make([]T, len(pools))
+				for i, idx := range indices {
+					out[i] = pools[i][idx]
+				}
+
+				if !yield(out, nil) {
+					return
+				}
+
+				// Advance the rightmost index that can still move, like an odometer.
+				i := len(indices) - 1
+				for ; i >= 0; i-- {
+					indices[i]++
+					if indices[i] < len(pools[i]) {
+						break
+					}
+
+					indices[i] = 0
+				}
+
+				if i < 0 {
+					return
+				}
+			}
+		},
+	}
+}
+
+// ProductOf is like Product, but for iterators. Every iterator but the first is read entirely and buffered before
+// anything is yielded, ending with the first error of any of them. The first one is streamed, its errors being passed
+// through.
+func ProductOf[T any](iterators ...Iterator[T]) Iterator[[]T] {
+	if len(iterators) == 0 {
+		return Product[T]()
+	}
+
+	return Iterator[[]T]{
+		it: func(yield func([]T, error) bool) {
+			pools := make([][]T, len(iterators)-1)
+
+			for i, iterator := range iterators[1:] {
+				pool, err := iterator.Collect()
+				if err != nil {
+					yield(nil, err)
+
+					return
+				}
+
+				if len(pool) == 0 {
+					return
+				}
+
+				pools[i] = pool
+			}
+
+			for v, err := range iterators[0].it {
+				if err != nil {
+					if !yield(nil, err) {
+						return
+					}
+
+					continue
+				}
+
+				for rest := range Product(pools...).it {
+					out := make([]T, 0, len(iterators))
+					out = append(append(out, v), rest...)
+
+					if !yield(out, nil) {
+						return
+					}
+				}
+			}
+		},
+	}
+}
+
+// Product2 returns the cartesian product of two slices as tuples.
+func Product2[T any, U any](a []T, b []U) Iterator[Tuple[T, U]] {
+	return Iterator[Tuple[T, U]]{
+		it: func(yield func(Tuple[T, U], error) bool) {
+			for _, va := range a {
+				for _, vb := range b {
+					if !yield(Tuple[T, U]{va, vb}, nil) {
+						return
+					}
+				}
+			}
+		},
+	}
+}
+
+// Combinations returns every k-length combination of values, in lexicographic order of their indices. Nothing is
+// yielded when k is negative or greater than the number of values.
+func Combinations[T any](values []T, k int) Iterator[[]T] {
+	return Iterator[[]T]{
+		it: func(yield func([]T, error) bool) {
+			n := len(values)
+			if k < 0 || k > n {
+				return
+			}
+
+			indices := make([]int, k)
+			for i := range indices {
+				indices[i] = i
+			}
+
+			for {
+				if !yield(pick(values, indices), nil) {
+					return
+				}
+
+				// Find the rightmost index that hasn't reached its maximum position.
+				i := k - 1
+				for ; i >= 0; i-- {
+					if indices[i] != i+n-k {
+						break
+					}
+				}
+
+				if i < 0 {
+					return
+				}
+
+				indices[i]++
+				for j := i + 1; j < k; j++ {
+					indices[j] = indices[j-1] + 1
+				}
+			}
+		},
+	}
+}
+
+// Permutations returns every ordering of values, in lexicographic order of their indices.
+func Permutations[T any](values []T) Iterator[[]T] {
+	return Iterator[[]T]{
+		it: func(yield func([]T, error) bool) {
+			indices := make([]int, len(values))
+			for i := range indices {
+				indices[i] = i
+			}
+
+			for {
+				if !yield(pick(values, indices), nil) {
+					return
+				}
+
+				if !nextPermutation(indices) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// PowerSet returns every subset of values, ordered by size and then lexicographically by index, starting with the
+// empty set.
+func PowerSet[T any](values []T) Iterator[[]T] {
+	return Iterator[[]T]{
+		it: func(yield func([]T, error) bool) {
+			for k := 0; k <= len(values); k++ {
+				for c := range Combinations(values, k).it {
+					if !yield(c, nil) {
+						return
+					}
+				}
+			}
+		},
+	}
+}
+
+func pick[T any](values []T, indices []int) []T {
+	out := make([]T, len(indices))
+	for i, idx := range indices {
+		out[i] = values[idx]
+	}
+
+	return out
+}
+
+// nextPermutation rearranges indices into the next lexicographic permutation and reports whether there was one.
+func nextPermutation(indices []int) bool {
+	i := len(indices) - 2
+	for i >= 0 && indices[i] >= indices[i+1] {
+		i--
+	}
+
+	if i < 0 {
+		return false
+	}
+
+	j := len(indices) - 1
+	for indices[j] <= indices[i] {
+		j--
+	}
+
+	indices[i], indices[j] = indices[j], indices[i]
+
+	for l, r := i+1, len(indices)-1; l < r; l, r = l+1, r-1 {
+		indices[l], indices[r] = indices[r], indices[l]
+	}
+
+	return true
+}
@@ -0,0 +1,197 @@
+package betteriter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProduct_ReturnsTheCartesianProduct(t *testing.T) {
+	output, err := Product([]int{1, 2}, []int{3}, []int{4, 5}).Collect()
+
+	require.NoError(t, err)
+
+	expected := [][]int{
+		{1, 3, 4},
+		{1, 3, 5},
+		{2, 3, 4},
+		{2, 3, 5},
+	}
+	assert.Equal(t, expected, output)
+}
+
+func TestProduct_ReturnsNothingIfAPoolIsEmpty(t *testing.T) {
+	output, err := Product([]int{1, 2}, []int{}).Collect()
+
+	require.NoError(t, err)
+	assert.Empty(t, output)
+}
+
+func TestProduct_ReturnsAnEmptyTupleWithoutPools(t *testing.T) {
+	output, err := Product[int]().Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, [][]int{{}}, output)
+}
+
+func TestProduct_IsLazy(t *testing.T) {
+	pool := make([]int, 1_000)
+
+	c := 0
+
+	for range Product(pool, pool, pool, pool).it {
+		c += 1
+
+		if c == 10 {
+			break
+		}
+	}
+
+	assert.Equal(t, 10, c)
+}
+
+func TestProductOf_ReturnsTheCartesianProductOfIterators(t *testing.T) {
+	first, pulled := counting([]int{1, 2})
+	output, err := ProductOf(first, NewRepeatN(3, 1), New([]int{4, 5})).Collect()
+
+	require.NoError(t, err)
+
+	expected := [][]int{
+		{1, 3, 4},
+		{1, 3, 5},
+		{2, 3, 4},
+		{2, 3, 5},
+	}
+	assert.Equal(t, expected, output)
+	assert.Equal(t, 2, *pulled)
+
+	output, err = ProductOf[int]().Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, [][]int{{}}, output)
+}
+
+func TestProductOf_ReturnsErrors(t *testing.T) {
+	output, err := ProductOf(New([]int{1}), failing([]int{2})).Collect()
+	assert.Empty(t, output)
+	require.ErrorContains(t, err, "Invalid value")
+
+	output, err = ProductOf(failing([]int{1}), New([]int{2})).Collect()
+	assert.Empty(t, output)
+	assert.ErrorContains(t, err, "Invalid value")
+}
+
+func TestProduct2_ReturnsTuples(t *testing.T) {
+	output, err := Product2([]int{1, 2}, []string{"a", "b"}).Collect()
+
+	require.NoError(t, err)
+
+	expected := []Tuple[int, string]{
+		{1, "a"},
+		{1, "b"},
+		{2, "a"},
+		{2, "b"},
+	}
+	assert.Equal(t, expected, output)
+}
+
+func TestCombinations_ReturnsAllCombinationsOfK(t *testing.T) {
+	output, err := Combinations([]string{"a", "b", "c", "d"}, 2).Collect()
+
+	require.NoError(t, err)
+
+	expected := [][]string{
+		{"a", "b"},
+		{"a", "c"},
+		{"a", "d"},
+		{"b", "c"},
+		{"b", "d"},
+		{"c", "d"},
+	}
+	assert.Equal(t, expected, output)
+}
+
+func TestCombinations_HandlesEdgeCases(t *testing.T) {
+	values := []int{1, 2, 3}
+
+	testCases := []struct {
+		msg      string
+		k        int
+		expected [][]int
+	}{
+		{"k is zero", 0, [][]int{{}}},
+		{"k is the length", 3, [][]int{{1, 2, 3}}},
+		{"k is too big", 4, [][]int{}},
+		{"k is negative", -1, [][]int{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			output, err := Combinations(values, tc.k).Collect()
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, output)
+		})
+	}
+}
+
+func TestPermutations_ReturnsAllPermutations(t *testing.T) {
+	output, err := Permutations([]int{1, 2, 3}).Collect()
+
+	require.NoError(t, err)
+
+	expected := [][]int{
+		{1, 2, 3},
+		{1, 3, 2},
+		{2, 1, 3},
+		{2, 3, 1},
+		{3, 1, 2},
+		{3, 2, 1},
+	}
+	assert.Equal(t, expected, output)
+}
+
+func TestPermutations_YieldsIndependentSlices(t *testing.T) {
+	output, err := Permutations([]int{1, 2}).Collect()
+
+	require.NoError(t, err)
+
+	output[0][0] = 42
+
+	assert.Equal(t, []int{2, 1}, output[1])
+}
+
+func TestPowerSet_ReturnsAllSubsets(t *testing.T) {
+	output, err := PowerSet([]int{1, 2, 3}).Collect()
+
+	require.NoError(t, err)
+
+	expected := [][]int{
+		{},
+		{1},
+		{2},
+		{3},
+		{1, 2},
+		{1, 3},
+		{2, 3},
+		{1, 2, 3},
+	}
+	assert.Equal(t, expected, output)
+}
+
+func TestPowerSet_IsLazy(t *testing.T) {
+	values := make([]int, 64)
+
+	c := 0
+
+	for range PowerSet(values).it {
+		c += 1
+
+		if c == 100 {
+			break
+		}
+	}
+
+	assert.Equal(t, 100, c)
+}
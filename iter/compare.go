@@ -0,0 +1,165 @@
+package betteriter
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Equal reports whether both iterators yield the same elements in the same order.
+func Equal[T comparable](a Iterator[T], b Iterator[T]) (bool, error) {
+	return EqualFunc(a, b, func(x T, y T) bool { return x == y })
+}
+
+// EqualFunc reports whether both iterators yield equal elements in the same order, according to eq.
+func EqualFunc[T any, U any](a Iterator[T], b Iterator[U], eq func(T, U) bool) (bool, error) {
+	res, err := compare(a, b, func(x T, y U) int {
+		if eq(x, y) {
+			return 0
+		}
+
+		return 1
+	})
+
+	return res == 0, err
+}
+
+// Compare compares the elements of both iterators lexicographically with cmp. The result is 0 if they are equal, -1
+// if a is less than b and +1 if a is greater than b, a being less than b if it's a prefix of b.
+func Compare[T any](a Iterator[T], b Iterator[T], cmp func(T, T) int) (int, error) {
+	return compare(a, b, cmp)
+}
+
+func compare[T any, U any](a Iterator[T], b Iterator[U], cmp func(T, U) int) (int, error) {
+	nextA, stopA := iter.Pull2(a.it)
+	defer stopA()
+
+	nextB, stopB := iter.Pull2(b.it)
+	defer stopB()
+
+	for {
+		va, errA, okA := nextA()
+		if errA != nil {
+			return 0, errA
+		}
+
+		vb, errB, okB := nextB()
+		if errB != nil {
+			return 0, errB
+		}
+
+		switch {
+		case !okA && !okB:
+			return 0, nil
+		case !okA:
+			return -1, nil
+		case !okB:
+			return 1, nil
+		}
+
+		if c := cmp(va, vb); c != 0 {
+			return min(max(c, -1), 1), nil
+		}
+	}
+}
+
+// EditOp is the kind of operation of an Edit.
+type EditOp int
+
+const (
+	// Keep means the element is in both iterators.
+	Keep EditOp = iota
+	// Delete means the element is only in the first iterator.
+	Delete
+	// Insert means the element is only in the second iterator.
+	Insert
+)
+
+func (op EditOp) String() string {
+	switch op {
+	case Keep:
+		return " "
+	case Delete:
+		return "-"
+	case Insert:
+		return "+"
+	default:
+		return "?"
+	}
+}
+
+// Edit is a single step of an edit script.
+type Edit[T any] struct {
+	Op    EditOp
+	Value T
+}
+
+func (e Edit[T]) String() string {
+	return fmt.Sprintf("%s %v", e.Op, e.Value)
+}
+
+// Diff yields the shortest edit script turning the elements of a into the elements of b, based on their longest
+// common subsequence. Both iterators are fully consumed before the first edit is yielded, and memory usage is
+// proportional to the product of their lengths, so it is meant for test-sized inputs.
+func Diff[T comparable](a Iterator[T], b Iterator[T]) Iterator[Edit[T]] {
+	return Iterator[Edit[T]]{
+		it: func(yield func(Edit[T], error) bool) {
+			va, err := a.Collect()
+			if err != nil {
+				yield(Edit[T]{}, err)
+
+				return
+			}
+
+			vb, err := b.Collect()
+			if err != nil {
+				yield(Edit[T]{}, err)
+
+				return
+			}
+
+			for _, e := range diff(va, vb) {
+				if !yield(e, nil) {
+					return
+				}
+			}
+		},
+	}
+}
+
+func diff[T comparable](a []T, b []T) []Edit[T] {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	edits := make([]Edit[T], 0, len(a)+len(b)-lcs[0][0])
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			edits = append(edits, Edit[T]{Keep, a[i]})
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			edits = append(edits, Edit[T]{Delete, a[i]})
+			i++
+		default:
+			edits = append(edits, Edit[T]{Insert, b[j]})
+			j++
+		}
+	}
+
+	return edits
+}
@@ -0,0 +1,126 @@
+package betteriter
+
+import (
+	"cmp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEqual_ComparesElements(t *testing.T) {
+	testCases := []struct {
+		msg      string
+		a        []int
+		b        []int
+		expected bool
+	}{
+		{"both empty", []int{}, []int{}, true},
+		{"same elements", []int{1, 2, 3}, []int{1, 2, 3}, true},
+		{"different elements", []int{1, 2, 3}, []int{1, 4, 3}, false},
+		{"a is shorter", []int{1, 2}, []int{1, 2, 3}, false},
+		{"b is shorter", []int{1, 2, 3}, []int{1, 2}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			res, err := Equal(New(tc.a), New(tc.b))
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, res)
+		})
+	}
+}
+
+func TestEqual_ReturnsErrors(t *testing.T) {
+	_, err := Equal(New([]int{1}), failing([]int{1}))
+
+	assert.ErrorContains(t, err, "Invalid value")
+}
+
+func TestEqualFunc_UsesTheComparator(t *testing.T) {
+	res, err := EqualFunc(New([]string{"A", "b"}), New([]string{"a", "B"}), strings.EqualFold)
+
+	require.NoError(t, err)
+	assert.True(t, res)
+}
+
+func TestCompare_ComparesLexicographically(t *testing.T) {
+	testCases := []struct {
+		msg      string
+		a        []int
+		b        []int
+		expected int
+	}{
+		{"both empty", []int{}, []int{}, 0},
+		{"same elements", []int{1, 2, 3}, []int{1, 2, 3}, 0},
+		{"a is less", []int{1, 2, 3}, []int{1, 4}, -1},
+		{"a is greater", []int{1, 5}, []int{1, 4, 3}, 1},
+		{"a is a prefix", []int{1, 2}, []int{1, 2, 3}, -1},
+		{"b is a prefix", []int{1, 2, 3}, []int{1, 2}, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			res, err := Compare(New(tc.a), New(tc.b), cmp.Compare[int])
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, res)
+		})
+	}
+}
+
+func TestCompare_ReturnsErrors(t *testing.T) {
+	_, err := Compare(failing([]int{1}), New([]int{1}), cmp.Compare[int])
+
+	assert.ErrorContains(t, err, "Invalid value")
+}
+
+func TestDiff_ReturnsTheEditScript(t *testing.T) {
+	a := New(strings.Split("ABCABBA", ""))
+	b := New(strings.Split("CBABAC", ""))
+
+	edits, err := Diff(a, b).Collect()
+	require.NoError(t, err)
+
+	// Applying the script must give back both sides
+	var before, after []string
+	kept := 0
+
+	for _, e := range edits {
+		switch e.Op {
+		case Keep:
+			before = append(before, e.Value)
+			after = append(after, e.Value)
+			kept += 1
+		case Delete:
+			before = append(before, e.Value)
+		case Insert:
+			after = append(after, e.Value)
+		}
+	}
+
+	assert.Equal(t, "ABCABBA", strings.Join(before, ""))
+	assert.Equal(t, "CBABAC", strings.Join(after, ""))
+	assert.Equal(t, 4, kept, "the longest common subsequence has 4 elements")
+}
+
+func TestDiff_FormatsEdits(t *testing.T) {
+	edits, err := Diff(New([]int{1, 2, 3}), New([]int{1, 3, 4})).Collect()
+	require.NoError(t, err)
+
+	lines := make([]string, len(edits))
+	for i, e := range edits {
+		lines[i] = e.String()
+	}
+
+	assert.Equal(t, []string{"  1", "- 2", "  3", "+ 4"}, lines)
+}
+
+func TestDiff_ReturnsErrors(t *testing.T) {
+	output, err := Diff(New([]int{1}), failing([]int{1})).Collect()
+
+	assert.Empty(t, output)
+	assert.ErrorContains(t, err, "Invalid value")
+}
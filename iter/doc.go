@@ -0,0 +1,27 @@
+// Package betteriter provides lazy iterators whose elements can carry an error.
+//
+// # Randomness
+//
+// The functions taking a *rand.Rand, like Sample, Shuffle or ExponentialBackoff, draw from the global source of
+// math/rand/v2 if it's nil. Pass a seeded generator for reproducible results.
+//
+// # Performance
+//
+// Iterators created with New are backed by their slice, and Filter, Skip, Collect and a single Map applied directly
+// on them range over the slice instead of calling a closure per element and stage. The result of Map is no longer
+// backed by a slice, so the stages after it, like in Filter(Map(New(...))) or Map(Map(New(...))), go through
+// closures like any other iterator.
+//
+// Allocations per operation over 1000 ints, for a slice-backed source (New) and a generic one (NewRepeatN), as
+// reported by `go test -run XXX -bench . -benchmem ./iter`:
+//
+//	                     slice-backed   generic
+//	Collect                         1         5
+//	Map + Collect                   7         9
+//	Filter + Collect                4        20   the generic output grows while appending, its final size being unknown
+//	Filter + Map + Sum              8        11
+//
+// None of these depend on the number of elements: the combinators don't allocate per element, except for the ones
+// buffering elements by design (Tee, Memoize, Shuffle, Diff, ...) and the ones yielding fresh slices (Product,
+// Combinations, Permutations, PowerSet).
+package betteriter
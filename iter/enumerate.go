@@ -0,0 +1,21 @@
+package betteriter
+
+// Enumerate pairs every element with its index, starting at 0.
+func Enumerate[T any](iterator Iterator[T]) Iterator[Tuple[int, T]] {
+	inner := func(yield func(Tuple[int, T], error) bool) {
+		idx := 0
+
+		for v, err := range iterator.it {
+			if !yield(Tuple[int, T]{idx, v}, err) {
+				return
+			}
+
+			idx++
+		}
+	}
+
+	return Iterator[Tuple[int, T]]{
+		it:   inner,
+		size: iterator.size,
+	}
+}
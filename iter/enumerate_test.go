@@ -0,0 +1,28 @@
+package betteriter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnumerate_PairsElementsWithTheirIndex(t *testing.T) {
+	output, err := Enumerate(New([]string{"a", "b", "c"})).Collect()
+
+	require.NoError(t, err)
+
+	expected := []Tuple[int, string]{
+		{0, "a"},
+		{1, "b"},
+		{2, "c"},
+	}
+	assert.Equal(t, expected, output)
+}
+
+func TestEnumerate_ForwardsErrors(t *testing.T) {
+	output, err := Enumerate(failing([]int{1})).Collect()
+
+	assert.Empty(t, output)
+	assert.ErrorContains(t, err, "Invalid value")
+}
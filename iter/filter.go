@@ -1,16 +1,33 @@
 package betteriter
 
+import "slices"
+
 func Filter[T any](iterator Iterator[T], f func(T) bool) Iterator[T] {
+	if iterator.src != nil {
+		return filterSlice(iterator, f)
+	}
+
 	inner := func(yield func(T, error) bool) {
 		for v, err := range iterator.it {
-			if f(v) && !yield(v, err) {
+			if (err != nil || f(v)) && !yield(v, err) {
 				return
 			}
 		}
 	}
 
 	return Iterator[T]{
-		inner,
-		nil,
+		it: inner,
+	}
+}
+
+// filterSlice keeps a filtered slice-backed iterator slice-backed, adding f to its predicates. Stacked filters are
+// checked in turn rather than nested in closures.
+func filterSlice[T any](iterator Iterator[T], f func(T) bool) Iterator[T] {
+	keep := append(slices.Clip(iterator.keep), f)
+
+	return Iterator[T]{
+		it:   sliceSeq(iterator.src, keep),
+		src:  iterator.src,
+		keep: keep,
 	}
 }
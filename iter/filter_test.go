@@ -1,6 +1,8 @@
 package betteriter
 
 import (
+	"path/filepath"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -38,3 +40,84 @@ func TestFilter_IsLazy(t *testing.T) {
 		}
 	}
 }
+
+func TestFilter_PassesSourceErrorsThrough(t *testing.T) {
+	filter := func(string) bool {
+		assert.Fail(t, "filter should not be called on a failed element")
+
+		return false
+	}
+
+	output, err := Filter(FileLines(filepath.Join(t.TempDir(), "missing.txt")), filter).Collect()
+
+	assert.Empty(t, output)
+	assert.ErrorContains(t, err, "failed to open file")
+}
+
+func TestFilter_ComposesFiltersOnSliceBackedIterators(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5, 6}
+
+	isOdd := func(i int) bool {
+		return i%2 == 1
+	}
+	isNotThree := func(i int) bool {
+		return i != 3
+	}
+
+	iter := Filter(Filter(New(values), isOdd), isNotThree)
+
+	output, err := iter.Collect()
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 5}, output)
+
+	ranged := make([]int, 0)
+	for v := range iter.it {
+		ranged = append(ranged, v)
+	}
+
+	assert.Equal(t, []int{1, 5}, ranged)
+}
+
+func TestFilter_CanBeFollowedByMap(t *testing.T) {
+	values := []int{1, 2, 3, 4}
+
+	isEven := func(i int) bool {
+		return i%2 == 0
+	}
+	mapper := func(i int) (string, error) {
+		return strconv.Itoa(i * i), nil
+	}
+
+	output, err := Map(Filter(New(values), isEven), mapper).Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"4", "16"}, output)
+}
+
+func TestFilter_StackedFiltersDoNotShareState(t *testing.T) {
+	base := Filter(New(sequence(10)), isEven)
+
+	small := Filter(base, func(i int) bool { return i < 5 })
+	large := Filter(base, func(i int) bool { return i >= 5 })
+
+	output, err := small.Collect()
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 2, 4}, output)
+
+	output, err = large.Collect()
+	require.NoError(t, err)
+	assert.Equal(t, []int{6, 8}, output)
+}
+
+func TestFilter_CollectPreallocatesForSliceBackedIterators(t *testing.T) {
+	values := make([]int, 1_000)
+
+	var output []int
+
+	allocs := testing.AllocsPerRun(100, func() {
+		output, _ = Filter(New(values), func(int) bool { return true }).Collect()
+	})
+
+	assert.Len(t, output, len(values))
+	assert.LessOrEqual(t, allocs, 4.0)
+}
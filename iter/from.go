@@ -0,0 +1,55 @@
+package betteriter
+
+import (
+	"iter"
+
+	"github.com/mathieu-lemay/go-sandbox/safetypes/option"
+	"github.com/mathieu-lemay/go-sandbox/safetypes/result"
+)
+
+// FromSeq creates an iterator yielding the elements of a standard library sequence.
+func FromSeq[T any](seq iter.Seq[T]) Iterator[T] {
+	inner := func(yield func(T, error) bool) {
+		for v := range seq {
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+
+	return Iterator[T]{
+		it: inner,
+	}
+}
+
+// FromOption creates an iterator yielding the value if the option is Some, and nothing if it's None.
+func FromOption[T any](opt option.Option[T]) Iterator[T] {
+	size := 0
+	if opt.IsSome() {
+		size = 1
+	}
+
+	return Iterator[T]{
+		it:   FromSeq(opt.All()).it,
+		size: size,
+	}
+}
+
+// FromResult creates an iterator yielding the value if the result is Ok, or the error if it's Err.
+func FromResult[T any, E error](res result.Result[T, E]) Iterator[T] {
+	inner := func(yield func(T, error) bool) {
+		if res.IsErr() {
+			var zero T
+			yield(zero, res.UnwrapErr())
+
+			return
+		}
+
+		yield(res.Unwrap(), nil)
+	}
+
+	return Iterator[T]{
+		it:   inner,
+		size: 1,
+	}
+}
@@ -0,0 +1,83 @@
+package betteriter
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mathieu-lemay/go-sandbox/safetypes/option"
+	"github.com/mathieu-lemay/go-sandbox/safetypes/result"
+)
+
+func TestFromSeq_YieldsTheElementsOfTheSequence(t *testing.T) {
+	output, err := FromSeq(slices.Values([]int{1, 2, 3})).Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, output)
+}
+
+func TestFromSeq_StopsWhenTheConsumerStops(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	count := 0
+
+	for range FromSeq(maps.Keys(m)).it {
+		count++
+
+		break
+	}
+
+	assert.Equal(t, 1, count)
+}
+
+func TestFromOption_YieldsTheValueIfSome(t *testing.T) {
+	output, err := FromOption(option.Some(42)).Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{42}, output)
+
+	output, err = FromOption(option.NoneOf[int]()).Collect()
+
+	require.NoError(t, err)
+	assert.Empty(t, output)
+}
+
+func TestFromResult_YieldsTheValueOrTheError(t *testing.T) {
+	output, err := FromResult(result.Ok[int, error](42)).Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{42}, output)
+
+	output, err = FromResult(result.Err[int](errors.New("failed"))).Collect()
+
+	assert.Empty(t, output)
+	assert.EqualError(t, err, "failed")
+}
+
+func TestFlatMap_FlattensOptionsAndResults(t *testing.T) {
+	lookup := map[string]int{"one": 1, "three": 3}
+
+	output, err := FlatMap(New([]string{"one", "two", "three"}), func(s string) Iterator[int] {
+		v, ok := lookup[s]
+
+		return FromOption(option.FromOk(v, ok))
+	}).Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 3}, output)
+
+	output, err = FlatMap(New([]int{1, 2, 3}), func(v int) Iterator[int] {
+		if v == 2 {
+			return FromResult(result.Err[int](errors.New("two")))
+		}
+
+		return FromResult(result.Ok[int, error](v))
+	}).Collect()
+
+	assert.Empty(t, output)
+	assert.EqualError(t, err, "two")
+}
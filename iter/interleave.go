@@ -0,0 +1,112 @@
+package betteriter
+
+import (
+	"errors"
+	"iter"
+)
+
+// Interleave alternates strictly between the iterators, one element at a time, and stops as soon as the next
+// iterator in turn is exhausted.
+func Interleave[T any](iterators ...Iterator[T]) Iterator[T] {
+	return Iterator[T]{
+		it: func(yield func(T, error) bool) {
+			if len(iterators) == 0 {
+				return
+			}
+
+			pulls, stop := pullAll(iterators)
+			defer stop()
+
+			for {
+				for _, next := range pulls {
+					v, err, ok := next()
+					if !ok {
+						return
+					}
+
+					if !yield(v, err) {
+						return
+					}
+				}
+			}
+		},
+	}
+}
+
+// RoundRobin alternates between the iterators, one element at a time, skipping the ones that are exhausted until all
+// of them are drained.
+func RoundRobin[T any](iterators ...Iterator[T]) Iterator[T] {
+	weights := make([]int, len(iterators))
+	for i := range weights {
+		weights[i] = 1
+	}
+
+	return WeightedRoundRobin(iterators, weights)
+}
+
+// WeightedRoundRobin takes up to weights[i] elements from iterators[i] in turn, skipping the ones that are exhausted
+// until all of them are drained.
+func WeightedRoundRobin[T any](iterators []Iterator[T], weights []int) Iterator[T] {
+	return Iterator[T]{
+		it: func(yield func(T, error) bool) {
+			if len(iterators) != len(weights) {
+				var zero T
+				yield(zero, errors.New("iterators and weights are not the same length"))
+
+				return
+			}
+
+			for _, w := range weights {
+				if w <= 0 {
+					var zero T
+					yield(zero, errors.New("weights must be positive"))
+
+					return
+				}
+			}
+
+			pulls, stop := pullAll(iterators)
+			defer stop()
+
+			active := len(pulls)
+			for active > 0 {
+				for i, next := range pulls {
+					if next == nil {
+						continue
+					}
+
+					for range weights[i] {
+						v, err, ok := next()
+						if !ok {
+							pulls[i] = nil
+							active--
+
+							break
+						}
+
+						if !yield(v, err) {
+							return
+						}
+					}
+				}
+			}
+		},
+	}
+}
+
+func pullAll[T any](iterators []Iterator[T]) ([]func() (T, error, bool), func()) {
+	pulls := make([]func() (T, error, bool), len(iterators))
+	stops := make([]func(), len(iterators))
+
+	for i, it := range iterators {
+		pulls[i], stops[i] = iter.Pull2(it.it)
+	}
+
+	stop := func() {
+		for _, s := range stops {
+			s()
+		}
+	}
+
+	return pulls, stop
+}
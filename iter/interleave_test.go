@@ -0,0 +1,100 @@
+package betteriter
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterleave_AlternatesBetweenIterators(t *testing.T) {
+	a := New([]int{1, 4, 7})
+	b := New([]int{2, 5, 8})
+	c := New([]int{3, 6, 9})
+
+	output, err := Interleave(a, b, c).Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, output)
+}
+
+func TestInterleave_StopsAtTheShortest(t *testing.T) {
+	a := New([]int{1, 3, 5, 7})
+	b := New([]int{2, 4})
+
+	output, err := Interleave(a, b).Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, output)
+}
+
+func TestInterleave_ReturnsNothingWithoutIterators(t *testing.T) {
+	output, err := Interleave[int]().Collect()
+
+	require.NoError(t, err)
+	assert.Empty(t, output)
+}
+
+func TestInterleave_ForwardsErrors(t *testing.T) {
+	a := New([]int{1, 3})
+	b := Map(New([]int{2, 4}), func(i int) (int, error) {
+		return 0, errors.New("Invalid value")
+	})
+
+	output, err := Interleave(a, b).Collect()
+
+	assert.Empty(t, output)
+	assert.ErrorContains(t, err, "Invalid value")
+}
+
+func TestRoundRobin_DrainsAllIterators(t *testing.T) {
+	a := New([]int{1, 4})
+	b := New([]int{2})
+	c := New([]int{3, 5, 6})
+
+	output, err := RoundRobin(a, b, c).Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, output)
+}
+
+func TestRoundRobin_IsLazy(t *testing.T) {
+	a := NewRepeat(1)
+	b := NewRepeat(2)
+
+	output := make([]int, 0)
+
+	for v := range RoundRobin(a, b).it {
+		output = append(output, v)
+
+		if len(output) == 5 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{1, 2, 1, 2, 1}, output)
+}
+
+func TestWeightedRoundRobin_TakesElementsAccordingToWeights(t *testing.T) {
+	a := NewRepeatN("a", 5)
+	b := NewRepeatN("b", 2)
+
+	output, err := WeightedRoundRobin([]Iterator[string]{a, b}, []int{3, 1}).Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "a", "a", "b", "a", "a", "b"}, output)
+}
+
+func TestWeightedRoundRobin_ReturnsAnErrorOnInvalidWeights(t *testing.T) {
+	a := NewRepeatN("a", 5)
+	b := NewRepeatN("b", 2)
+
+	output, err := WeightedRoundRobin([]Iterator[string]{a, b}, []int{1}).Collect()
+	assert.ErrorContains(t, err, "iterators and weights are not the same length")
+	assert.Empty(t, output)
+
+	output, err = WeightedRoundRobin([]Iterator[string]{a, b}, []int{1, 0}).Collect()
+	assert.ErrorContains(t, err, "weights must be positive")
+	assert.Empty(t, output)
+}
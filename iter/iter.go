@@ -8,36 +8,101 @@ import (
 type Iterator[T any] struct {
 	it  iter.Seq2[T, error]
 	err error
-}
 
-type Tuple[T any, U any] struct {
-	A T
-	B U
+	// src and keep are set when the iterator yields the elements of src for which every predicate of keep returns
+	// true. Filter appends to keep, and Collect, Skip and Map range over src directly instead of going through it,
+	// which saves a closure call per element and stage. Only those stages are fused: the result of Map isn't backed
+	// by a slice anymore, so the stages after it go through it.
+	src  []T
+	keep []func(T) bool
+
+	// size is how many elements the iterator is expected to yield, 0 if unknown. It's only used to preallocate.
+	size int
 }
 
 // TODO: make this a functions?
 func (i Iterator[T]) Collect() ([]T, error) {
-	output := make([]T, 0)
+	if i.src != nil {
+		return collectSlice(i.src, i.keep), nil
+	}
 
-	for v, err := range i.it {
-		if err != nil {
-			return nil, err
+	return collectSeq(i.it, i.size)
+}
+
+// collectSeq is kept apart from Collect because ranging over a function moves its results and the output slice to
+// the heap, which would also cost the slice-backed fast path.
+func collectSeq[T any](seq iter.Seq2[T, error], size int) ([]T, error) {
+	output := make([]T, 0, size)
+
+	var err error
+
+	for v, e := range seq {
+		if e != nil {
+			err = e
+
+			break
 		}
+
 		output = append(output, v)
 	}
 
+	if err != nil {
+		return nil, err
+	}
+
 	return output, nil
 }
 
+func collectSlice[T any](src []T, keep []func(T) bool) []T {
+	if keep == nil {
+		output := make([]T, len(src))
+		copy(output, src)
+
+		return output
+	}
+
+	// The source is an upper bound of the output, which saves growing it while appending.
+	output := make([]T, 0, len(src))
+
+	for _, v := range src {
+		if kept(keep, v) {
+			output = append(output, v)
+		}
+	}
+
+	return output
+}
+
+// kept returns true if v satisfies every predicate of keep.
+func kept[T any](keep []func(T) bool, v T) bool {
+	for _, f := range keep {
+		if !f(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func New[T any](values []T) Iterator[T] {
 	return Iterator[T]{
-		it: func(yield func(T, error) bool) {
-			for _, v := range values {
-				if !yield(v, nil) {
-					return
-				}
+		it:   sliceSeq(values, nil),
+		src:  values,
+		size: len(values),
+	}
+}
+
+func sliceSeq[T any](values []T, keep []func(T) bool) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for _, v := range values {
+			if !kept(keep, v) {
+				continue
 			}
-		},
+
+			if !yield(v, nil) {
+				return
+			}
+		}
 	}
 }
 
@@ -62,6 +127,7 @@ func NewRepeatN[T any](v T, n int) Iterator[T] {
 				}
 			}
 		},
+		size: max(n, 0),
 	}
 }
 
@@ -80,6 +146,7 @@ func Zip[T any, U any](a []T, b []U) Iterator[Tuple[T, U]] {
 				}
 			}
 		},
+		size: min(len(a), len(b)),
 	}
 }
 
@@ -99,5 +166,39 @@ func ZipEq[T any, U any](a []T, b []U) Iterator[Tuple[T, U]] {
 				}
 			}
 		},
+		size: len(a),
+	}
+}
+
+func Zip3[T any, U any, V any](a []T, b []U, c []V) Iterator[Tuple3[T, U, V]] {
+	n := min(len(a), len(b), len(c))
+
+	return Iterator[Tuple3[T, U, V]]{
+		it: func(yield func(Tuple3[T, U, V], error) bool) {
+			for idx := range n {
+				t := Tuple3[T, U, V]{a[idx], b[idx], c[idx]}
+
+				if !yield(t, nil) {
+					return
+				}
+			}
+		},
+		size: n,
+	}
+}
+
+func Unzip[T any, U any](iterator Iterator[Tuple[T, U]]) ([]T, []U, error) {
+	as := make([]T, 0, iterator.size)
+	bs := make([]U, 0, iterator.size)
+
+	for v, err := range iterator.it {
+		if err != nil {
+			return nil, nil, err
+		}
+
+		as = append(as, v.A)
+		bs = append(bs, v.B)
 	}
+
+	return as, bs, nil
 }
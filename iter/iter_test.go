@@ -118,3 +118,70 @@ func TestZipEq_ReturnsAnErrorIfSlicesAreNotSameLength(t *testing.T) {
 	assert.ErrorContains(t, err, "slices are not the same length")
 	assert.Empty(t, output)
 }
+
+func TestCollect_ReturnsACopyOfSliceBackedIterators(t *testing.T) {
+	values := []int{1, 2, 3}
+
+	output, err := New(values).Collect()
+	require.NoError(t, err)
+
+	output[0] = 42
+
+	assert.Equal(t, []int{1, 2, 3}, values)
+}
+
+func TestCollect_PreallocatesUsingTheSizeHint(t *testing.T) {
+	n := fake.IntBetween(1, 10_000)
+
+	output, err := NewRepeatN(1, n).Collect()
+
+	require.NoError(t, err)
+	assert.Len(t, output, n)
+	assert.Equal(t, n, cap(output))
+}
+
+func TestCollect_AllocatesOnceForSliceBackedIterators(t *testing.T) {
+	values := make([]int, 1_000)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = New(values).Collect()
+	})
+
+	assert.LessOrEqual(t, allocs, 1.0)
+}
+
+func TestZip3_ReturnsValuesFromThreeSlices(t *testing.T) {
+	a := []int{1, 2, 3}
+	b := []string{"one", "two", "three", "four"}
+	c := []bool{true, false, true}
+
+	output, err := Zip3(a, b, c).Collect()
+
+	require.NoError(t, err)
+
+	expected := []Tuple3[int, string, bool]{
+		{1, "one", true},
+		{2, "two", false},
+		{3, "three", true},
+	}
+	assert.Equal(t, expected, output)
+}
+
+func TestUnzip_SplitsTuples(t *testing.T) {
+	a := []int{1, 2, 3}
+	b := []string{"one", "two", "three"}
+
+	outA, outB, err := Unzip(Zip(a, b))
+
+	require.NoError(t, err)
+	assert.Equal(t, a, outA)
+	assert.Equal(t, b, outB)
+}
+
+func TestUnzip_ReturnsErrors(t *testing.T) {
+	outA, outB, err := Unzip(ZipEq([]int{1}, []int{}))
+
+	assert.Nil(t, outA)
+	assert.Nil(t, outB)
+	assert.ErrorContains(t, err, "slices are not the same length")
+}
@@ -0,0 +1,145 @@
+package betteriter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrDeadlineExceeded is the reason a TakeFor iterator ended early. It matches context.DeadlineExceeded.
+	ErrDeadlineExceeded = fmt.Errorf("iterator %w", context.DeadlineExceeded)
+	// ErrBudgetExhausted is the reason a TakeWhileBudget iterator ended early.
+	ErrBudgetExhausted = errors.New("budget exhausted")
+)
+
+// Checkpoint reports how far a limited iterator got. It's updated as the iterator is consumed.
+type Checkpoint struct {
+	// Offset is the number of elements yielded, errors included. Passing it to Skip on a new instance of a
+	// deterministic source resumes from where the iterator stopped.
+	Offset int
+	// Spent is the cost of the yielded elements, for TakeWhileBudget.
+	Spent int
+	// Done is true once the source is exhausted.
+	Done bool
+	// Err is the reason the iterator ended before its source, or nil.
+	Err error
+}
+
+// TakeFor yields elements until d has elapsed since the iteration started, then ends gracefully with
+// ErrDeadlineExceeded as the reason in the returned checkpoint. The deadline is checked before pulling each element
+// from the source, so no element is pulled and then dropped; an element whose pull outlasts the deadline is still
+// yielded. The checkpoint is reset every time the iterator is consumed.
+func TakeFor[T any](iterator Iterator[T], d time.Duration, opts ...TimingConfig) (Iterator[T], *Checkpoint) {
+	clock := newTimingOptions(opts).Clock
+	checkpoint := &Checkpoint{} //nolint:exhaustruct  // Starts at zero
+
+	inner := func(yield func(T, error) bool) {
+		*checkpoint = Checkpoint{} //nolint:exhaustruct  // Starts at zero
+
+		deadline := clock.Now().Add(d)
+		expired := func() bool {
+			if clock.Now().Before(deadline) {
+				return false
+			}
+
+			checkpoint.Err = ErrDeadlineExceeded
+
+			return true
+		}
+
+		if expired() {
+			return
+		}
+
+		for v, err := range iterator.it {
+			checkpoint.Offset++
+
+			if !yield(v, err) || expired() {
+				return
+			}
+		}
+
+		checkpoint.Done = true
+	}
+
+	// No size hint: the iterator can end long before its source, and the hint would make Collect overallocate.
+	return Iterator[T]{
+		it: inner,
+	}, checkpoint
+}
+
+// TakeWhileBudget yields elements as long as their total cost stays within budget, then ends gracefully with
+// ErrBudgetExhausted as the reason in the returned checkpoint. Errors have no cost. The checkpoint is reset every time
+// the iterator is consumed.
+func TakeWhileBudget[T any](iterator Iterator[T], cost func(T) int, budget int) (Iterator[T], *Checkpoint) {
+	checkpoint := &Checkpoint{} //nolint:exhaustruct  // Starts at zero
+
+	inner := func(yield func(T, error) bool) {
+		*checkpoint = Checkpoint{} //nolint:exhaustruct  // Starts at zero
+
+		for v, err := range iterator.it {
+			c := 0
+			if err == nil {
+				c = cost(v)
+			}
+
+			if checkpoint.Spent+c > budget {
+				checkpoint.Err = ErrBudgetExhausted
+
+				return
+			}
+
+			checkpoint.Spent += c
+			checkpoint.Offset++
+
+			if !yield(v, err) {
+				return
+			}
+		}
+
+		checkpoint.Done = true
+	}
+
+	// No size hint, like TakeFor.
+	return Iterator[T]{
+		it: inner,
+	}, checkpoint
+}
+
+// Skip drops the first n elements of the iterator, errors included.
+func Skip[T any](iterator Iterator[T], n int) Iterator[T] {
+	n = max(n, 0)
+
+	if iterator.src != nil && iterator.keep == nil {
+		src := iterator.src[min(n, len(iterator.src)):]
+
+		return Iterator[T]{
+			it:   sliceSeq(src, nil),
+			src:  src,
+			size: len(src),
+		}
+	}
+
+	inner := func(yield func(T, error) bool) {
+		skipped := 0
+
+		for v, err := range iterator.it {
+			if skipped < n {
+				skipped++
+
+				continue
+			}
+
+			if !yield(v, err) {
+				return
+			}
+		}
+	}
+
+	return Iterator[T]{
+		it:   inner,
+		size: max(iterator.size-n, 0),
+	}
+}
@@ -0,0 +1,197 @@
+package betteriter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTakeFor_StopsWhenTheDurationHasElapsed(t *testing.T) {
+	clock := newFakeClock()
+	values := []int{1, 2, 3, 4, 5}
+	delays := []time.Duration{0, time.Second, time.Second, time.Second, time.Second}
+
+	it, checkpoint := TakeFor(advancing(clock, values, delays), 2500*time.Millisecond, WithClock(clock))
+
+	output, err := it.Collect()
+
+	// 4 was pulled before the deadline was checked, so it's yielded rather than dropped
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4}, output)
+	assert.Equal(t, 4, checkpoint.Offset)
+	assert.False(t, checkpoint.Done)
+	require.ErrorIs(t, checkpoint.Err, ErrDeadlineExceeded)
+	assert.ErrorIs(t, checkpoint.Err, context.DeadlineExceeded)
+}
+
+func TestTakeFor_DoesNotPullElementsPastTheDeadline(t *testing.T) {
+	clock := newFakeClock()
+	pulled := 0
+
+	source := Map(New(sequence(10)), func(v int) (int, error) {
+		pulled++
+
+		return v, nil
+	})
+
+	it, checkpoint := TakeFor(source, 1500*time.Millisecond, WithClock(clock))
+
+	yielded := 0
+
+	for range it.it {
+		yielded++
+
+		clock.Advance(time.Second)
+	}
+
+	assert.Equal(t, 2, yielded)
+	assert.Equal(t, 2, pulled)
+	assert.Equal(t, 2, checkpoint.Offset)
+	assert.ErrorIs(t, checkpoint.Err, ErrDeadlineExceeded)
+}
+
+func TestTakeFor_ResetsTheCheckpointOnEveryPass(t *testing.T) {
+	it, checkpoint := TakeFor(New([]int{1, 2, 3}), time.Minute, WithClock(newFakeClock()))
+
+	for range 2 {
+		output, err := it.Collect()
+
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, output)
+		assert.Equal(t, 3, checkpoint.Offset)
+		assert.True(t, checkpoint.Done)
+	}
+}
+
+func TestTakeFor_ReportsWhenTheSourceIsExhausted(t *testing.T) {
+	it, checkpoint := TakeFor(New([]int{1, 2, 3}), time.Minute, WithClock(newFakeClock()))
+
+	output, err := it.Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, output)
+	assert.Equal(t, 3, checkpoint.Offset)
+	assert.True(t, checkpoint.Done)
+	assert.NoError(t, checkpoint.Err)
+}
+
+func TestTakeWhileBudget_StopsWhenTheBudgetIsExhausted(t *testing.T) {
+	values := []string{"ab", "cde", "f", "ghij", "k"}
+	cost := func(s string) int {
+		return len(s)
+	}
+
+	it, checkpoint := TakeWhileBudget(New(values), cost, 7)
+
+	output, err := it.Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ab", "cde", "f"}, output)
+	assert.Equal(t, 3, checkpoint.Offset)
+	assert.Equal(t, 6, checkpoint.Spent)
+	assert.False(t, checkpoint.Done)
+	assert.ErrorIs(t, checkpoint.Err, ErrBudgetExhausted)
+}
+
+func TestTakeWhileBudget_ResetsTheCheckpointOnEveryPass(t *testing.T) {
+	cost := func(int) int {
+		return 1
+	}
+
+	it, checkpoint := TakeWhileBudget(New(sequence(10)), cost, 3)
+
+	for range 2 {
+		output, err := it.Collect()
+
+		require.NoError(t, err)
+		assert.Equal(t, []int{0, 1, 2}, output)
+		assert.Equal(t, 3, checkpoint.Offset)
+		assert.Equal(t, 3, checkpoint.Spent)
+		assert.ErrorIs(t, checkpoint.Err, ErrBudgetExhausted)
+	}
+}
+
+func TestTakeWhileBudget_DoesNotPreallocateForTheSource(t *testing.T) {
+	cost := func(int) int {
+		return 1
+	}
+
+	it, _ := TakeWhileBudget(NewRepeatN(1, 50_000_000), cost, 3)
+
+	output, err := it.Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 1, 1}, output)
+	assert.Less(t, cap(output), 1000)
+}
+
+func TestTakeWhileBudget_ReportsWhenTheSourceIsExhausted(t *testing.T) {
+	cost := func(i int) int {
+		return i
+	}
+
+	it, checkpoint := TakeWhileBudget(New([]int{1, 2, 3}), cost, 6)
+
+	output, err := it.Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, output)
+	assert.Equal(t, 6, checkpoint.Spent)
+	assert.True(t, checkpoint.Done)
+	assert.NoError(t, checkpoint.Err)
+}
+
+func TestTakeWhileBudget_CanBeResumedWithSkip(t *testing.T) {
+	values := sequence(20)
+	cost := func(int) int {
+		return 1
+	}
+
+	output := make([]int, 0)
+	offset := 0
+
+	for {
+		it, checkpoint := TakeWhileBudget(Skip(New(values), offset), cost, 7)
+
+		batch, err := it.Collect()
+		require.NoError(t, err)
+
+		output = append(output, batch...)
+		offset += checkpoint.Offset
+
+		if checkpoint.Done {
+			break
+		}
+	}
+
+	assert.Equal(t, values, output)
+}
+
+func TestSkip_DropsTheFirstElements(t *testing.T) {
+	testCases := []struct {
+		msg      string
+		n        int
+		expected []int
+	}{
+		{"none", 0, []int{1, 2, 3}},
+		{"some", 2, []int{3}},
+		{"all", 3, []int{}},
+		{"more than all", 5, []int{}},
+		{"negative", -1, []int{1, 2, 3}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			output, err := Skip(New([]int{1, 2, 3}), tc.n).Collect()
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, output)
+
+			output, err = Skip(Filter(New([]int{1, 2, 3}), func(int) bool { return true }), tc.n).Collect()
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, output)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package betteriter
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Lines yields the lines read from r, without their line endings. It can only be consumed once.
+func Lines(r io.Reader) Iterator[string] {
+	return Iterator[string]{
+		it: func(yield func(string, error) bool) {
+			scanLines(r, yield)
+		},
+	}
+}
+
+// FileLines yields the lines of the file at path, without their line endings. The file is opened every time the
+// iterator is ranged over, so it can be consumed multiple times.
+func FileLines(path string) Iterator[string] {
+	return Iterator[string]{
+		it: func(yield func(string, error) bool) {
+			f, err := os.Open(path)
+			if err != nil {
+				yield("", fmt.Errorf("failed to open file: %w", err))
+
+				return
+			}
+			defer f.Close()
+
+			scanLines(f, yield)
+		},
+	}
+}
+
+func scanLines(r io.Reader, yield func(string, error) bool) {
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		if !yield(scanner.Text(), nil) {
+			return
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		yield("", fmt.Errorf("failed to read lines: %w", err))
+	}
+}
@@ -0,0 +1,54 @@
+package betteriter
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("disk on fire")
+}
+
+func TestLines_YieldsLines(t *testing.T) {
+	output, err := Lines(strings.NewReader("one\ntwo\r\nthree")).Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"one", "two", "three"}, output)
+}
+
+func TestLines_ReturnsReadErrors(t *testing.T) {
+	output, err := Lines(failingReader{}).Collect()
+
+	assert.Empty(t, output)
+	assert.ErrorContains(t, err, "failed to read lines: disk on fire")
+}
+
+func TestFileLines_CanBeConsumedMultipleTimes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lines.txt")
+	require.NoError(t, os.WriteFile(path, []byte("one\ntwo\n"), 0o600))
+
+	it := FileLines(path)
+
+	for range 2 {
+		output, err := it.Collect()
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"one", "two"}, output)
+	}
+}
+
+func TestFileLines_ReturnsAnErrorIfFileDoesNotExist(t *testing.T) {
+	output, err := FileLines(filepath.Join(t.TempDir(), "missing.txt")).Collect()
+
+	assert.Empty(t, output)
+	require.ErrorIs(t, err, os.ErrNotExist)
+	assert.ErrorContains(t, err, "failed to open file")
+}
@@ -1,8 +1,21 @@
 package betteriter
 
 func Map[T any, U any](iterator Iterator[T], f func(T) (U, error)) Iterator[U] {
+	if iterator.src != nil {
+		return mapSlice(iterator, f)
+	}
+
 	inner := func(yield func(U, error) bool) {
-		for v, _ := range iterator.it {
+		for v, err := range iterator.it {
+			if err != nil {
+				var zero U
+				if !yield(zero, err) {
+					return
+				}
+
+				continue
+			}
+
 			if !yield(f(v)) {
 				return
 			}
@@ -10,7 +23,61 @@ func Map[T any, U any](iterator Iterator[T], f func(T) (U, error)) Iterator[U] {
 	}
 
 	return Iterator[U]{
-		inner,
-		nil,
+		it:   inner,
+		size: iterator.size,
+	}
+}
+
+// mapSlice fuses f with the source slice and filter of a slice-backed iterator.
+func mapSlice[T any, U any](iterator Iterator[T], f func(T) (U, error)) Iterator[U] {
+	src, keep := iterator.src, iterator.keep
+
+	inner := func(yield func(U, error) bool) {
+		for _, v := range src {
+			if !kept(keep, v) {
+				continue
+			}
+
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}
+
+	size := 0
+	if keep == nil {
+		size = len(src)
+	}
+
+	return Iterator[U]{
+		it:   inner,
+		size: size,
+	}
+}
+
+// FlatMap maps every element to an iterator and yields the elements of each in turn. Errors from the source are
+// passed through.
+func FlatMap[T any, U any](iterator Iterator[T], f func(T) Iterator[U]) Iterator[U] {
+	inner := func(yield func(U, error) bool) {
+		for v, err := range iterator.it {
+			if err != nil {
+				var zero U
+				if !yield(zero, err) {
+					return
+				}
+
+				continue
+			}
+
+			for u, err := range f(v).it {
+				if !yield(u, err) {
+					return
+				}
+			}
+		}
+	}
+
+	return Iterator[U]{
+		it: inner,
 	}
 }
@@ -2,6 +2,7 @@ package betteriter
 
 import (
 	"errors"
+	"path/filepath"
 	"strconv"
 	"testing"
 
@@ -60,3 +61,75 @@ func TestMap_StopsOnError(t *testing.T) {
 	assert.Empty(t, output)
 	assert.ErrorContains(t, err, "Invalid value")
 }
+
+func TestMap_PassesSourceErrorsThrough(t *testing.T) {
+	mapper := func(s string) (int, error) {
+		assert.Fail(t, "mapper should not be called on a failed element")
+
+		return len(s), nil
+	}
+
+	output, err := Map(FileLines(filepath.Join(t.TempDir(), "missing.txt")), mapper).Collect()
+
+	assert.Empty(t, output)
+	assert.ErrorContains(t, err, "failed to open file")
+}
+
+func TestMap_PreservesTheSizeHint(t *testing.T) {
+	mapper := func(i int) (int, error) {
+		return i, nil
+	}
+
+	output, err := Map(New(make([]int, 10)), mapper).Collect()
+	require.NoError(t, err)
+	assert.Equal(t, 10, cap(output))
+
+	output, err = Map(NewRepeatN(1, 10), mapper).Collect()
+	require.NoError(t, err)
+	assert.Equal(t, 10, cap(output))
+}
+
+func TestMap_DoesNotAllocatePerElement(t *testing.T) {
+	mapper := func(i int) (int, error) {
+		return i * 2, nil
+	}
+	isEven := func(i int) bool {
+		return i%2 == 0
+	}
+
+	allocs := func(n int) float64 {
+		values := make([]int, n)
+
+		return testing.AllocsPerRun(100, func() {
+			_, _ = Sum(Map(Filter(New(values), isEven), mapper))
+		})
+	}
+
+	assert.Equal(t, allocs(10), allocs(10_000)) //nolint:testifylint  // Allocation counts are whole numbers
+}
+
+func TestFlatMap_YieldsTheElementsOfEveryIterator(t *testing.T) {
+	output, err := FlatMap(New([]int{1, 2, 3}), func(v int) Iterator[int] {
+		return NewRepeatN(v, v)
+	}).Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 2, 3, 3, 3}, output)
+}
+
+func TestFlatMap_IsLazy(t *testing.T) {
+	for v := range FlatMap(NewRepeat(1), func(v int) Iterator[int] { return NewRepeat(v) }).it {
+		assert.Equal(t, 1, v)
+
+		break
+	}
+}
+
+func TestFlatMap_PassesSourceErrorsThrough(t *testing.T) {
+	output, err := FlatMap(failing([]int{1, 2}), func(v int) Iterator[int] {
+		return New([]int{v})
+	}).Collect()
+
+	assert.Empty(t, output)
+	assert.EqualError(t, err, "Invalid value")
+}
@@ -0,0 +1,126 @@
+package betteriter
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+)
+
+var (
+	// ErrMemoizeLimit is returned by a memoized iterator when its source has more elements than it can cache.
+	ErrMemoizeLimit = errors.New("memoize limit exceeded")
+	// ErrMemoizeStopped is returned by a memoized iterator that was stopped before its source was exhausted.
+	ErrMemoizeStopped = errors.New("memoize stopped before the end of the source")
+)
+
+// Memoize caches the elements of the iterator as they are consumed, so it can be ranged over multiple times while the
+// source is only read once. The first error is cached too and ends the iterator. Ranging over the memoized iterator
+// again resumes reading the source where a previous, interrupted pass stopped.
+//
+// Resuming keeps the source suspended between passes, which holds a goroutine and whatever the source holds, like an
+// open file. The returned stop function releases them once the source won't be read further; later passes replay the
+// cached elements and end with ErrMemoizeStopped, since the rest of the source is lost. It's safe to call more than
+// once and is a no-op once the source is exhausted, but it must be called if a pass may have been interrupted.
+func Memoize[T any](iterator Iterator[T]) (Iterator[T], func()) {
+	return memoize(iterator, -1)
+}
+
+// MemoizeN is like Memoize, but caches at most n elements and ends with ErrMemoizeLimit if the source has more.
+func MemoizeN[T any](iterator Iterator[T], n int) (Iterator[T], func()) {
+	return memoize(iterator, max(n, 0))
+}
+
+func memoize[T any](iterator Iterator[T], limit int) (Iterator[T], func()) {
+	m := &memo[T]{
+		src:   iterator.it,
+		limit: limit,
+		cache: nil,
+		err:   nil,
+		next:  nil,
+		stop:  nil,
+		done:  false,
+	}
+
+	return Iterator[T]{
+		it: m.iterate,
+	}, m.cancel
+}
+
+type memo[T any] struct {
+	src   iter.Seq2[T, error]
+	limit int
+
+	cache []T
+	err   error
+
+	next func() (T, error, bool)
+	stop func()
+	done bool
+}
+
+func (m *memo[T]) iterate(yield func(T, error) bool) {
+	for i := 0; ; i++ {
+		if i < len(m.cache) {
+			if !yield(m.cache[i], nil) {
+				return
+			}
+
+			continue
+		}
+
+		if m.done {
+			if m.err != nil {
+				var zero T
+				yield(zero, m.err)
+			}
+
+			return
+		}
+
+		m.pull()
+
+		// Either the cache grew or the memo is done, try the same position again.
+		i--
+	}
+}
+
+func (m *memo[T]) pull() {
+	if m.next == nil {
+		m.next, m.stop = iter.Pull2(m.src)
+	}
+
+	v, err, ok := m.next()
+
+	switch {
+	case !ok:
+	case err != nil:
+		m.err = err
+	case m.limit >= 0 && len(m.cache) >= m.limit:
+		m.err = fmt.Errorf("%w: more than %d elements", ErrMemoizeLimit, m.limit)
+	default:
+		m.cache = append(m.cache, v)
+
+		return
+	}
+
+	m.release()
+}
+
+// cancel releases the source and, if it wasn't exhausted, records that the cache is incomplete.
+func (m *memo[T]) cancel() {
+	if !m.done {
+		m.err = ErrMemoizeStopped
+	}
+
+	m.release()
+}
+
+// release marks the memo as done and stops the suspended source, if any.
+func (m *memo[T]) release() {
+	m.done = true
+
+	if m.stop != nil {
+		m.stop()
+		m.next, m.stop = nil, nil
+	}
+}
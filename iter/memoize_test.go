@@ -0,0 +1,239 @@
+package betteriter
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// counting returns an iterator over values that can only be consumed once, along with the number of pulled elements.
+func counting[T any](values []T) (Iterator[T], *int) {
+	pulled := 0
+	consumed := false
+
+	return Iterator[T]{
+		it: func(yield func(T, error) bool) {
+			if consumed {
+				return
+			}
+
+			consumed = true
+
+			for _, v := range values {
+				pulled += 1
+
+				if !yield(v, nil) {
+					return
+				}
+			}
+		},
+	}, &pulled
+}
+
+func TestMemoize_ReplaysElements(t *testing.T) {
+	source, pulled := counting([]int{1, 2, 3})
+	memo, stop := Memoize(source)
+	defer stop()
+
+	for range 3 {
+		output, err := memo.Collect()
+
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, output)
+	}
+
+	assert.Equal(t, 3, *pulled)
+}
+
+func TestMemoize_ResumesAnInterruptedPass(t *testing.T) {
+	source, pulled := counting([]int{1, 2, 3, 4})
+	memo, stop := Memoize(source)
+	defer stop()
+
+	for v := range memo.it {
+		if v == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, 2, *pulled)
+
+	output, err := memo.Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4}, output)
+	assert.Equal(t, 4, *pulled)
+}
+
+func TestMemoize_ReplaysTheError(t *testing.T) {
+	calls := 0
+	source := Map(New([]int{1, 2, 3}), func(i int) (int, error) {
+		calls += 1
+
+		if i == 2 {
+			return 0, errors.New("Invalid value")
+		}
+
+		return i, nil
+	})
+	memo, stop := Memoize(source)
+	defer stop()
+
+	for range 2 {
+		output := make([]int, 0)
+
+		var err error
+		for v, e := range memo.it {
+			if e != nil {
+				err = e
+
+				continue
+			}
+
+			output = append(output, v)
+		}
+
+		assert.Equal(t, []int{1}, output)
+		assert.ErrorContains(t, err, "Invalid value")
+	}
+
+	assert.Equal(t, 2, calls)
+}
+
+func TestMemoizeN_ReturnsAnErrorWhenTheLimitIsExceeded(t *testing.T) {
+	memo, stop := MemoizeN(New([]int{1, 2, 3}), 2)
+	defer stop()
+
+	output, err := memo.Collect()
+	assert.Empty(t, output)
+	require.ErrorIs(t, err, ErrMemoizeLimit)
+	assert.ErrorContains(t, err, "more than 2 elements")
+
+	var values []int
+	for v, err := range memo.it {
+		if err == nil {
+			values = append(values, v)
+		}
+	}
+
+	assert.Equal(t, []int{1, 2}, values)
+}
+
+func TestMemoizeN_CachesUpToTheLimit(t *testing.T) {
+	memo, stop := MemoizeN(New([]int{1, 2, 3}), 3)
+	defer stop()
+
+	output, err := memo.Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, output)
+}
+
+func TestMemoize_StopReleasesAnInterruptedSource(t *testing.T) {
+	before := runtime.NumGoroutine()
+	stops := make([]func(), 0, 100)
+
+	for range 100 {
+		memo, stop := Memoize(NewRepeat(1))
+
+		for range memo.it {
+			break
+		}
+
+		stops = append(stops, stop)
+	}
+
+	assert.GreaterOrEqual(t, runtime.NumGoroutine(), before+100, "interrupted passes should hold their source")
+
+	for _, stop := range stops {
+		stop()
+		stop()
+	}
+
+	// Not assert.Eventually, which runs the condition in a goroutine of its own
+	for deadline := time.Now().Add(time.Second); runtime.NumGoroutine() > before && time.Now().Before(deadline); {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	assert.LessOrEqual(t, runtime.NumGoroutine(), before)
+}
+
+func TestMemoize_StopClosesAnInterruptedFile(t *testing.T) {
+	fds, err := os.ReadDir("/proc/self/fd")
+	if err != nil {
+		t.Skip("open file descriptors can't be listed on this platform")
+	}
+
+	path := filepath.Join(t.TempDir(), "lines.txt")
+	require.NoError(t, os.WriteFile(path, []byte("one\ntwo\nthree\n"), 0o600))
+
+	memo, stop := Memoize(FileLines(path))
+
+	for range memo.it {
+		break
+	}
+
+	open, err := os.ReadDir("/proc/self/fd")
+	require.NoError(t, err)
+	assert.Len(t, open, len(fds)+1, "the file should be open while the pass is suspended")
+
+	stop()
+
+	closed, err := os.ReadDir("/proc/self/fd")
+	require.NoError(t, err)
+	assert.Len(t, closed, len(fds))
+
+	var values []string
+	for v, err := range memo.it {
+		if err != nil {
+			require.ErrorIs(t, err, ErrMemoizeStopped)
+
+			continue
+		}
+
+		values = append(values, v)
+	}
+
+	assert.Equal(t, []string{"one"}, values, "later passes only replay the cache")
+
+	_, err = memo.Collect()
+	assert.ErrorIs(t, err, ErrMemoizeStopped)
+}
+
+func TestMemoize_StopBeforeTheEndReturnsAnError(t *testing.T) {
+	source, _ := counting([]int{1, 2, 3, 4})
+	memo, stop := Memoize(source)
+
+	for v := range memo.it {
+		if v == 2 {
+			break
+		}
+	}
+
+	stop()
+
+	output, err := memo.Collect()
+
+	assert.Empty(t, output)
+	assert.ErrorIs(t, err, ErrMemoizeStopped)
+}
+
+func TestMemoize_StopAfterTheEndIsANoop(t *testing.T) {
+	memo, stop := Memoize(New([]int{1, 2, 3}))
+
+	_, err := memo.Collect()
+	require.NoError(t, err)
+
+	stop()
+
+	output, err := memo.Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, output)
+}
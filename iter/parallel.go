@@ -0,0 +1,157 @@
+package betteriter
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+const parallelChunkSize = 256
+
+type parallelChunk[T any] struct {
+	idx    int
+	values []T
+}
+
+// ParallelReduce splits the iterator in chunks that are reduced concurrently by up to workers goroutines, or
+// GOMAXPROCS if workers isn't positive. Each chunk is reduced from identity by combining it with mapFn of each
+// element, then the partial results are combined in the order of their chunks, so the result is deterministic as long
+// as combineFn is associative and identity is its identity element. The first error, from the source or from mapFn,
+// cancels the other workers and is returned, as is the error of ctx if it's canceled before the end.
+func ParallelReduce[T any, R any](
+	ctx context.Context,
+	iterator Iterator[T],
+	workers int,
+	identity R,
+	mapFn func(T) (R, error),
+	combineFn func(R, R) R,
+) (R, error) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		partials []R
+		firstErr error
+		wg       sync.WaitGroup
+	)
+
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+
+	chunks := make(chan parallelChunk[T])
+
+	for range workers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for c := range chunks {
+				acc, err := reduceChunk(ctx, c.values, identity, mapFn, combineFn)
+				if err != nil {
+					fail(err)
+
+					continue
+				}
+
+				mu.Lock()
+				if missing := c.idx + 1 - len(partials); missing > 0 {
+					partials = append(partials, make([]R, missing)...)
+				}
+				partials[c.idx] = acc
+				mu.Unlock()
+			}
+		}()
+	}
+
+	produceChunks(ctx, iterator, chunks, fail)
+	close(chunks)
+	wg.Wait()
+
+	if firstErr != nil {
+		return identity, firstErr
+	}
+
+	if err := ctx.Err(); err != nil {
+		return identity, err
+	}
+
+	result := identity
+	for _, p := range partials {
+		result = combineFn(result, p)
+	}
+
+	return result, nil
+}
+
+func produceChunks[T any](ctx context.Context, iterator Iterator[T], chunks chan<- parallelChunk[T], fail func(error)) {
+	idx := 0
+	buf := make([]T, 0, parallelChunkSize)
+
+	send := func() bool {
+		select {
+		case chunks <- parallelChunk[T]{idx, buf}:
+			idx++
+			buf = make([]T, 0, parallelChunkSize)
+
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	for v, err := range iterator.it {
+		if err != nil {
+			fail(err)
+
+			return
+		}
+
+		buf = append(buf, v)
+
+		if len(buf) == parallelChunkSize && !send() {
+			return
+		}
+	}
+
+	if len(buf) > 0 {
+		send()
+	}
+}
+
+func reduceChunk[T any, R any](
+	ctx context.Context,
+	values []T,
+	identity R,
+	mapFn func(T) (R, error),
+	combineFn func(R, R) R,
+) (R, error) {
+	acc := identity
+
+	for _, v := range values {
+		if err := ctx.Err(); err != nil {
+			return identity, err
+		}
+
+		r, err := mapFn(v)
+		if err != nil {
+			return identity, err
+		}
+
+		acc = combineFn(acc, r)
+	}
+
+	return acc, nil
+}
@@ -0,0 +1,106 @@
+package betteriter
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func identity[T any](v T) (T, error) {
+	return v, nil
+}
+
+func add(a int, b int) int {
+	return a + b
+}
+
+func TestParallelReduce_ReducesAllElements(t *testing.T) {
+	n := fake.IntBetween(1, 10_000)
+	values := sequence(n)
+
+	for _, workers := range []int{0, 1, 4, 16} {
+		sum, err := ParallelReduce(context.Background(), New(values), workers, 0, identity[int], add)
+
+		require.NoError(t, err)
+		assert.Equal(t, n*(n-1)/2, sum)
+	}
+}
+
+func TestParallelReduce_IsDeterministicForAssociativeOperations(t *testing.T) {
+	values := sequence(2_000)
+	concat := func(a string, b string) string {
+		return a + b
+	}
+	toString := func(i int) (string, error) {
+		return strconv.Itoa(i) + ",", nil
+	}
+
+	expected := ""
+	for _, v := range values {
+		expected += strconv.Itoa(v) + ","
+	}
+
+	for range 10 {
+		res, err := ParallelReduce(context.Background(), New(values), 8, "", toString, concat)
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, res)
+	}
+}
+
+func TestParallelReduce_ReturnsTheIdentityIfIteratorIsEmpty(t *testing.T) {
+	res, err := ParallelReduce(context.Background(), New([]int{}), 4, 42, identity[int], add)
+
+	require.NoError(t, err)
+	assert.Equal(t, 42, res)
+}
+
+func TestParallelReduce_ReturnsMapperErrors(t *testing.T) {
+	var calls atomic.Int64
+
+	mapper := func(i int) (int, error) {
+		calls.Add(1)
+
+		if i == 10 {
+			return 0, errors.New("Invalid value")
+		}
+
+		return i, nil
+	}
+
+	res, err := ParallelReduce(context.Background(), NewRepeat(10), 4, 0, mapper, add)
+
+	assert.Equal(t, 0, res)
+	require.ErrorContains(t, err, "Invalid value")
+	assert.Positive(t, calls.Load())
+}
+
+func TestParallelReduce_ReturnsSourceErrors(t *testing.T) {
+	res, err := ParallelReduce(context.Background(), failing([]int{1}), 4, 0, identity[int], add)
+
+	assert.Equal(t, 0, res)
+	assert.ErrorContains(t, err, "Invalid value")
+}
+
+func TestParallelReduce_StopsWhenContextIsCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var calls atomic.Int64
+
+	mapper := func(i int) (int, error) {
+		if calls.Add(1) == 100 {
+			cancel()
+		}
+
+		return i, nil
+	}
+
+	_, err := ParallelReduce(ctx, NewRepeat(1), 4, 0, mapper, add)
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
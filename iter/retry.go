@@ -0,0 +1,106 @@
+package betteriter
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// Backoff returns how long to wait before the given retry, starting at 1 for the first retry.
+type Backoff func(retry int) time.Duration
+
+// ConstantBackoff always waits for d between attempts.
+func ConstantBackoff(d time.Duration) Backoff {
+	return func(int) time.Duration {
+		return d
+	}
+}
+
+// ExponentialBackoff waits for initial, multiplied by multiplier on every retry, capped to maxDelay. When jitter is
+// greater than 0, each delay is randomly reduced by up to that fraction, using rng or the global source if rng is nil.
+func ExponentialBackoff(
+	initial time.Duration,
+	maxDelay time.Duration,
+	multiplier float64,
+	jitter float64,
+	rng *rand.Rand,
+) Backoff {
+	rng = randOrGlobal(rng)
+
+	return func(retry int) time.Duration {
+		d := math.Min(float64(initial)*math.Pow(multiplier, float64(retry-1)), float64(maxDelay))
+
+		if jitter > 0 {
+			d -= d * jitter * rng.Float64()
+		}
+
+		return time.Duration(d)
+	}
+}
+
+// RetryPolicy describes how a failing operation is retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one. Values below 1 mean a single attempt.
+	MaxAttempts int
+	// Backoff is the delay between attempts. A nil Backoff retries right away.
+	Backoff Backoff
+	// Retryable reports whether an error is worth retrying. A nil Retryable retries every error.
+	Retryable func(error) bool
+}
+
+// MapRetry is like Map, but calls to f that fail are retried according to policy before the error is yielded.
+// Errors from the source iterator are yielded as is.
+func MapRetry[T any, U any](
+	iterator Iterator[T],
+	policy RetryPolicy,
+	f func(T) (U, error),
+	opts ...TimingConfig,
+) Iterator[U] {
+	clock := newTimingOptions(opts).Clock
+
+	inner := func(yield func(U, error) bool) {
+		for v, err := range iterator.it {
+			if err != nil {
+				var zero U
+				if !yield(zero, err) {
+					return
+				}
+
+				continue
+			}
+
+			if !yield(retry(policy, clock, func() (U, error) { return f(v) })) {
+				return
+			}
+		}
+	}
+
+	return Iterator[U]{
+		it:   inner,
+		size: iterator.size,
+	}
+}
+
+func retry[T any](policy RetryPolicy, clock Clock, f func() (T, error)) (T, error) {
+	attempts := max(policy.MaxAttempts, 1)
+
+	for attempt := 1; ; attempt++ {
+		v, err := f()
+		if err == nil {
+			return v, nil
+		}
+
+		if policy.Retryable != nil && !policy.Retryable(err) {
+			return v, err
+		}
+
+		if attempt >= attempts {
+			return v, fmt.Errorf("giving up after %d attempts: %w", attempt, err)
+		}
+
+		if policy.Backoff != nil {
+			clock.Sleep(policy.Backoff(attempt))
+		}
+	}
+}
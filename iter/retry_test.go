@@ -0,0 +1,133 @@
+package betteriter
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errTransient = errors.New("transient error")
+
+// flaky returns a mapper that fails with errTransient the given number of times for each value before succeeding.
+func flaky(failures int) (func(int) (int, error), map[int]int) {
+	calls := make(map[int]int)
+
+	return func(i int) (int, error) {
+		calls[i] += 1
+
+		if calls[i] <= failures {
+			return 0, errTransient
+		}
+
+		return i * 10, nil
+	}, calls
+}
+
+func TestConstantBackoff_AlwaysReturnsTheSameDelay(t *testing.T) {
+	b := ConstantBackoff(time.Second)
+
+	assert.Equal(t, time.Second, b(1))
+	assert.Equal(t, time.Second, b(10))
+}
+
+func TestExponentialBackoff_GrowsUpToTheMaximum(t *testing.T) {
+	b := ExponentialBackoff(100*time.Millisecond, time.Second, 2, 0, nil)
+
+	assert.Equal(t, 100*time.Millisecond, b(1))
+	assert.Equal(t, 200*time.Millisecond, b(2))
+	assert.Equal(t, 400*time.Millisecond, b(3))
+	assert.Equal(t, 800*time.Millisecond, b(4))
+	assert.Equal(t, time.Second, b(5))
+}
+
+func TestExponentialBackoff_AppliesJitter(t *testing.T) {
+	b := ExponentialBackoff(time.Second, time.Minute, 2, 0.5, rand.New(rand.NewPCG(1, 2)))
+
+	for retry := 1; retry < 5; retry++ {
+		base := time.Second << (retry - 1)
+		d := b(retry)
+
+		assert.GreaterOrEqual(t, d, base/2)
+		assert.LessOrEqual(t, d, base)
+	}
+}
+
+func TestMapRetry_RetriesTransientErrors(t *testing.T) {
+	clock := newFakeClock()
+	f, calls := flaky(2)
+	policy := RetryPolicy{
+		MaxAttempts: 3,
+		Backoff:     ConstantBackoff(time.Second),
+		Retryable:   nil,
+	}
+
+	output, err := MapRetry(New([]int{1, 2}), policy, f, WithClock(clock)).Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{10, 20}, output)
+	assert.Equal(t, map[int]int{1: 3, 2: 3}, calls)
+	assert.Equal(t, []time.Duration{time.Second, time.Second, time.Second, time.Second}, clock.slept)
+}
+
+func TestMapRetry_GivesUpAfterMaxAttempts(t *testing.T) {
+	clock := newFakeClock()
+	f, calls := flaky(5)
+	policy := RetryPolicy{
+		MaxAttempts: 3,
+		Backoff:     ExponentialBackoff(time.Second, time.Minute, 2, 0, nil),
+		Retryable:   nil,
+	}
+
+	output, err := MapRetry(New([]int{1, 2}), policy, f, WithClock(clock)).Collect()
+
+	assert.Empty(t, output)
+	require.ErrorIs(t, err, errTransient)
+	assert.ErrorContains(t, err, "giving up after 3 attempts")
+	assert.Equal(t, map[int]int{1: 3}, calls)
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second}, clock.slept)
+}
+
+func TestMapRetry_DoesNotRetryPermanentErrors(t *testing.T) {
+	clock := newFakeClock()
+	permanent := errors.New("permanent error")
+	calls := 0
+	f := func(int) (int, error) {
+		calls += 1
+
+		return 0, permanent
+	}
+	policy := RetryPolicy{
+		MaxAttempts: 3,
+		Backoff:     ConstantBackoff(time.Second),
+		Retryable: func(err error) bool {
+			return errors.Is(err, errTransient)
+		},
+	}
+
+	output, err := MapRetry(New([]int{1}), policy, f, WithClock(clock)).Collect()
+
+	assert.Empty(t, output)
+	assert.Equal(t, permanent, err)
+	assert.Equal(t, 1, calls)
+	assert.Empty(t, clock.slept)
+}
+
+func TestMapRetry_ForwardsSourceErrors(t *testing.T) {
+	source := Map(New([]int{1}), func(int) (int, error) {
+		return 0, errors.New("Invalid value")
+	})
+	f := func(int) (int, error) {
+		assert.Fail(t, "mapper should not have been called")
+
+		return 0, nil
+	}
+
+	output, err := MapRetry(source, RetryPolicy{}, f).Collect() //nolint:exhaustruct  // Default policy
+
+	assert.Empty(t, output)
+	assert.ErrorContains(t, err, "Invalid value")
+}
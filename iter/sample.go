@@ -0,0 +1,148 @@
+package betteriter
+
+import (
+	"errors"
+	"math/rand/v2"
+)
+
+// Sample consumes the iterator and returns k elements chosen uniformly at random with reservoir sampling, or all of
+// them if there are fewer than k. It draws from rng, or from the global source if rng is nil.
+func Sample[T any](iterator Iterator[T], k int, rng *rand.Rand) ([]T, error) {
+	rng = randOrGlobal(rng)
+	reservoir := make([]T, 0, bufferSize(k, iterator.size))
+
+	if k <= 0 {
+		return reservoir, nil
+	}
+
+	seen := 0
+
+	for v, err := range iterator.it {
+		if err != nil {
+			return nil, err
+		}
+
+		seen++
+
+		if len(reservoir) < k {
+			reservoir = append(reservoir, v)
+
+			continue
+		}
+
+		if j := rng.IntN(seen); j < k {
+			reservoir[j] = v
+		}
+	}
+
+	return reservoir, nil
+}
+
+// SampleRate keeps each element with probability p. Errors are always yielded. It draws from rng, or from the global
+// source if rng is nil.
+func SampleRate[T any](iterator Iterator[T], p float64, rng *rand.Rand) Iterator[T] {
+	rng = randOrGlobal(rng)
+
+	return Iterator[T]{
+		it: func(yield func(T, error) bool) {
+			if p < 0 || p > 1 {
+				var zero T
+				yield(zero, errors.New("rate must be between 0 and 1"))
+
+				return
+			}
+
+			for v, err := range iterator.it {
+				if err == nil && rng.Float64() >= p {
+					continue
+				}
+
+				if !yield(v, err) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// Shuffle yields the elements in a random order, using a buffer of up to size elements. Each element is yielded
+// from a random position of the buffer and replaced by the next one from the source, so the result is a full shuffle
+// when size is at least the number of elements. Errors are yielded as soon as they are encountered. It draws from rng,
+// or from the global source if rng is nil.
+func Shuffle[T any](iterator Iterator[T], size int, rng *rand.Rand) Iterator[T] {
+	rng = randOrGlobal(rng)
+
+	return Iterator[T]{
+		it: func(yield func(T, error) bool) {
+			if size <= 0 {
+				var zero T
+				yield(zero, errors.New("buffer size must be positive"))
+
+				return
+			}
+
+			buf := make([]T, 0, bufferSize(size, iterator.size))
+
+			for v, err := range iterator.it {
+				if err != nil {
+					if !yield(v, err) {
+						return
+					}
+
+					continue
+				}
+
+				if len(buf) < size {
+					buf = append(buf, v)
+
+					continue
+				}
+
+				j := rng.IntN(size)
+				out := buf[j]
+				buf[j] = v
+
+				if !yield(out, nil) {
+					return
+				}
+			}
+
+			rng.Shuffle(len(buf), func(i, j int) {
+				buf[i], buf[j] = buf[j], buf[i]
+			})
+
+			for _, v := range buf {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		},
+		size: iterator.size,
+	}
+}
+
+// bufferSize is how much to preallocate for a buffer of up to n elements: no more than the size hint, and nothing if
+// it's unknown, since n may be far larger than the source.
+func bufferSize(n int, hint int) int {
+	if n <= 0 || hint <= 0 {
+		return 0
+	}
+
+	return min(n, hint)
+}
+
+// globalSource draws from the global source of math/rand/v2.
+type globalSource struct{}
+
+func (globalSource) Uint64() uint64 {
+	return rand.Uint64()
+}
+
+// randOrGlobal returns rng, or a generator backed by the global source if rng is nil.
+func randOrGlobal(rng *rand.Rand) *rand.Rand {
+	if rng == nil {
+		return rand.New(globalSource{})
+	}
+
+	return rng
+}
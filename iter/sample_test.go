@@ -0,0 +1,160 @@
+package betteriter
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func seeded() *rand.Rand {
+	return rand.New(rand.NewPCG(42, 1337))
+}
+
+func sequence(n int) []int {
+	values := make([]int, n)
+	for i := range values {
+		values[i] = i
+	}
+
+	return values
+}
+
+func TestSample_ReturnsKElements(t *testing.T) {
+	values := sequence(1_000)
+
+	output, err := Sample(New(values), 10, seeded())
+
+	require.NoError(t, err)
+	assert.Len(t, output, 10)
+
+	for _, v := range output {
+		assert.Contains(t, values, v)
+	}
+}
+
+func TestSample_IsReproducible(t *testing.T) {
+	values := sequence(1_000)
+
+	a, err := Sample(New(values), 10, seeded())
+	require.NoError(t, err)
+
+	b, err := Sample(New(values), 10, seeded())
+	require.NoError(t, err)
+
+	assert.Equal(t, a, b)
+}
+
+func TestSample_ReturnsAllElementsIfThereAreFewerThanK(t *testing.T) {
+	output, err := Sample(New([]int{1, 2, 3}), 10, seeded())
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, output)
+}
+
+func TestSample_IsUniform(t *testing.T) {
+	rng := seeded()
+	counts := make([]int, 10)
+
+	for range 10_000 {
+		output, err := Sample(New(sequence(10)), 1, rng)
+		require.NoError(t, err)
+
+		counts[output[0]] += 1
+	}
+
+	for _, c := range counts {
+		assert.InDelta(t, 1_000, c, 150)
+	}
+}
+
+func TestSample_DoesNotPreallocateForLargeK(t *testing.T) {
+	sized, err := Sample(New([]int{1, 2}), 1<<20, seeded())
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, cap(sized), "the size hint should cap the reservoir")
+
+	source, _ := counting([]int{1, 2})
+	unsized, err := Sample(source, 1<<20, seeded())
+
+	require.NoError(t, err)
+	assert.LessOrEqual(t, cap(unsized), 2, "the reservoir should grow with the source")
+}
+
+func TestSample_UsesTheGlobalSourceIfRngIsNil(t *testing.T) {
+	output, err := Sample(New(sequence(100)), 10, nil)
+
+	require.NoError(t, err)
+	assert.Len(t, output, 10)
+
+	output, err = SampleRate(New(sequence(100)), 0.5, nil).Collect()
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, output)
+
+	output, err = Shuffle(New(sequence(100)), 10, nil).Collect()
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, sequence(100), output)
+}
+
+func TestSample_ReturnsErrors(t *testing.T) {
+	output, err := Sample(failing([]int{1}), 1, seeded())
+
+	assert.Empty(t, output)
+	assert.ErrorContains(t, err, "Invalid value")
+}
+
+func TestSampleRate_KeepsElementsWithProbability(t *testing.T) {
+	output, err := SampleRate(New(sequence(10_000)), 0.1, seeded()).Collect()
+
+	require.NoError(t, err)
+	assert.InDelta(t, 1_000, len(output), 150)
+	assert.True(t, slices.IsSorted(output), "order should be preserved")
+}
+
+func TestSampleRate_ReturnsAnErrorOnInvalidRate(t *testing.T) {
+	output, err := SampleRate(New([]int{1}), 1.5, seeded()).Collect()
+
+	assert.Empty(t, output)
+	assert.ErrorContains(t, err, "rate must be between 0 and 1")
+}
+
+func TestShuffle_ReturnsAPermutation(t *testing.T) {
+	values := sequence(100)
+
+	for _, size := range []int{1, 10, 100, 1_000} {
+		output, err := Shuffle(New(values), size, seeded()).Collect()
+		require.NoError(t, err)
+
+		assert.ElementsMatch(t, values, output)
+
+		if size > 1 {
+			assert.NotEqual(t, values, output)
+		}
+	}
+}
+
+func TestShuffle_IsReproducible(t *testing.T) {
+	values := sequence(100)
+
+	a, err := Shuffle(New(values), 10, seeded()).Collect()
+	require.NoError(t, err)
+
+	b, err := Shuffle(New(values), 10, seeded()).Collect()
+	require.NoError(t, err)
+
+	assert.Equal(t, a, b)
+}
+
+func TestShuffle_ReturnsErrors(t *testing.T) {
+	output, err := Shuffle(failing([]int{1}), 10, seeded()).Collect()
+	assert.Empty(t, output)
+	assert.ErrorContains(t, err, "Invalid value")
+
+	output, err = Shuffle(New([]int{1}), 0, seeded()).Collect()
+	assert.Empty(t, output)
+	assert.ErrorContains(t, err, "buffer size must be positive")
+}
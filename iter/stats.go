@@ -0,0 +1,294 @@
+package betteriter
+
+import (
+	"errors"
+	"math"
+	"slices"
+)
+
+// Number is a constraint for the types supporting arithmetic operations.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Sum returns the sum of all elements, or 0 if the iterator is empty.
+func Sum[T Number](iterator Iterator[T]) (T, error) {
+	var sum T
+
+	for v, err := range iterator.it {
+		if err != nil {
+			return 0, err
+		}
+
+		sum += v
+	}
+
+	return sum, nil
+}
+
+// Prod returns the product of all elements, or 1 if the iterator is empty.
+func Prod[T Number](iterator Iterator[T]) (T, error) {
+	var prod T = 1
+
+	for v, err := range iterator.it {
+		if err != nil {
+			return 0, err
+		}
+
+		prod *= v
+	}
+
+	return prod, nil
+}
+
+// Mean returns the arithmetic mean of all elements. It fails if the iterator is empty.
+func Mean[T Number](iterator Iterator[T]) (float64, error) {
+	stats, err := CollectStats(iterator)
+	if err != nil {
+		return 0, err
+	}
+
+	if stats.Count() == 0 {
+		return 0, errors.New("iterator is empty")
+	}
+
+	return stats.Mean(), nil
+}
+
+// CollectStats consumes the iterator and returns its statistics.
+func CollectStats[T Number](iterator Iterator[T]) (Stats, error) {
+	var stats Stats
+
+	for v, err := range iterator.it {
+		if err != nil {
+			return Stats{}, err
+		}
+
+		stats.Add(float64(v))
+	}
+
+	return stats, nil
+}
+
+// Quantile returns an approximation of the p-quantile of all elements, using constant memory. It fails if the
+// iterator is empty.
+func Quantile[T Number](iterator Iterator[T], p float64) (float64, error) {
+	q, err := NewP2Quantile(p)
+	if err != nil {
+		return 0, err
+	}
+
+	for v, err := range iterator.it {
+		if err != nil {
+			return 0, err
+		}
+
+		q.Add(float64(v))
+	}
+
+	if q.Count() == 0 {
+		return 0, errors.New("iterator is empty")
+	}
+
+	return q.Value(), nil
+}
+
+// Stats accumulates the count, minimum, maximum, mean and variance of a stream of values in a single pass, using
+// Welford's algorithm. The zero value is ready to use and all statistics are 0 until a value is added.
+type Stats struct {
+	count int
+	min   float64
+	max   float64
+	mean  float64
+	m2    float64
+}
+
+// Add adds a value to the statistics.
+func (s *Stats) Add(x float64) {
+	s.count++
+
+	if s.count == 1 {
+		s.min, s.max = x, x
+	} else {
+		s.min = math.Min(s.min, x)
+		s.max = math.Max(s.max, x)
+	}
+
+	delta := x - s.mean
+	s.mean += delta / float64(s.count)
+	s.m2 += delta * (x - s.mean)
+}
+
+// Count returns the number of values added.
+func (s *Stats) Count() int {
+	return s.count
+}
+
+// Min returns the smallest value added.
+func (s *Stats) Min() float64 {
+	return s.min
+}
+
+// Max returns the largest value added.
+func (s *Stats) Max() float64 {
+	return s.max
+}
+
+// Mean returns the arithmetic mean of the values added.
+func (s *Stats) Mean() float64 {
+	return s.mean
+}
+
+// Variance returns the population variance of the values added.
+func (s *Stats) Variance() float64 {
+	if s.count == 0 {
+		return 0
+	}
+
+	return s.m2 / float64(s.count)
+}
+
+// SampleVariance returns the sample variance of the values added, with Bessel's correction.
+func (s *Stats) SampleVariance() float64 {
+	if s.count < 2 { //nolint:mnd  // Needs at least two values
+		return 0
+	}
+
+	return s.m2 / float64(s.count-1)
+}
+
+// StdDev returns the population standard deviation of the values added.
+func (s *Stats) StdDev() float64 {
+	return math.Sqrt(s.Variance())
+}
+
+const p2Markers = 5
+
+// P2Quantile estimates a quantile of a stream of values in constant memory, using the P² algorithm from Jain and
+// Chlamtac.
+type P2Quantile struct {
+	p     float64
+	count int
+
+	// q holds the marker heights, n their actual positions and np their desired positions.
+	q  [p2Markers]float64
+	n  [p2Markers]float64
+	np [p2Markers]float64
+	dn [p2Markers]float64
+}
+
+// NewP2Quantile creates an estimator for the p-quantile, with p between 0 and 1.
+func NewP2Quantile(p float64) (*P2Quantile, error) {
+	if p < 0 || p > 1 {
+		return nil, errors.New("quantile must be between 0 and 1")
+	}
+
+	return &P2Quantile{
+		p:     p,
+		count: 0,
+		q:     [p2Markers]float64{},
+		n:     [p2Markers]float64{0, 1, 2, 3, 4},
+		np:    [p2Markers]float64{0, 2 * p, 4 * p, 2 + 2*p, 4},
+		dn:    [p2Markers]float64{0, p / 2, p, (1 + p) / 2, 1},
+	}, nil
+}
+
+// Add adds a value to the estimator.
+func (e *P2Quantile) Add(x float64) {
+	if e.count < p2Markers {
+		e.q[e.count] = x
+		e.count++
+
+		if e.count == p2Markers {
+			slices.Sort(e.q[:])
+		}
+
+		return
+	}
+
+	e.count++
+
+	var k int
+
+	switch {
+	case x < e.q[0]:
+		e.q[0] = x
+		k = 0
+	case x >= e.q[4]:
+		e.q[4] = x
+		k = 3
+	default:
+		for k = 0; x >= e.q[k+1]; k++ {
+		}
+	}
+
+	for i := k + 1; i < p2Markers; i++ {
+		e.n[i]++
+	}
+
+	for i := range p2Markers {
+		e.np[i] += e.dn[i]
+	}
+
+	for i := 1; i < p2Markers-1; i++ {
+		d := e.np[i] - e.n[i]
+
+		if (d >= 1 && e.n[i+1]-e.n[i] > 1) || (d <= -1 && e.n[i-1]-e.n[i] < -1) {
+			d = math.Copysign(1, d)
+
+			q := e.parabolic(i, d)
+			if e.q[i-1] >= q || q >= e.q[i+1] {
+				q = e.linear(i, d)
+			}
+
+			e.q[i] = q
+			e.n[i] += d
+		}
+	}
+}
+
+// Count returns the number of values added.
+func (e *P2Quantile) Count() int {
+	return e.count
+}
+
+// Value returns the estimated quantile, which is exact up to five values. The 0- and 1-quantiles are always exact,
+// since they're the minimum and maximum.
+func (e *P2Quantile) Value() float64 {
+	if e.count == 0 {
+		return 0
+	}
+
+	if e.count <= p2Markers {
+		values := slices.Clone(e.q[:e.count])
+		slices.Sort(values)
+
+		return values[int(math.Round(e.p*float64(e.count-1)))]
+	}
+
+	// The outer markers track the minimum and maximum exactly, the middle one only converges toward them.
+	switch e.p {
+	case 0:
+		return e.q[0]
+	case 1:
+		return e.q[4]
+	default:
+		return e.q[2]
+	}
+}
+
+func (e *P2Quantile) parabolic(i int, d float64) float64 {
+	q, n := e.q, e.n
+
+	return q[i] + d/(n[i+1]-n[i-1])*
+		((n[i]-n[i-1]+d)*(q[i+1]-q[i])/(n[i+1]-n[i])+
+			(n[i+1]-n[i]-d)*(q[i]-q[i-1])/(n[i]-n[i-1]))
+}
+
+func (e *P2Quantile) linear(i int, d float64) float64 {
+	j := i + int(d)
+
+	return e.q[i] + d*(e.q[j]-e.q[i])/(e.n[j]-e.n[i])
+}
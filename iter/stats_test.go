@@ -0,0 +1,192 @@
+package betteriter
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func failing[T any](values []T) Iterator[T] {
+	return Map(New(values), func(T) (T, error) {
+		var zero T
+
+		return zero, errors.New("Invalid value")
+	})
+}
+
+func TestSum_ReturnsTheSumOfElements(t *testing.T) {
+	sum, err := Sum(New([]int{1, 2, 3, 4}))
+	require.NoError(t, err)
+	assert.Equal(t, 10, sum)
+
+	fsum, err := Sum(New([]float64{0.5, 0.25}))
+	require.NoError(t, err)
+	assert.InDelta(t, 0.75, fsum, 1e-9)
+
+	empty, err := Sum(New([]uint8{}))
+	require.NoError(t, err)
+	assert.Equal(t, uint8(0), empty)
+}
+
+func TestSum_ReturnsErrors(t *testing.T) {
+	_, err := Sum(failing([]int{1}))
+
+	assert.ErrorContains(t, err, "Invalid value")
+}
+
+func TestProd_ReturnsTheProductOfElements(t *testing.T) {
+	prod, err := Prod(New([]int{1, 2, 3, 4}))
+	require.NoError(t, err)
+	assert.Equal(t, 24, prod)
+
+	empty, err := Prod(New([]int{}))
+	require.NoError(t, err)
+	assert.Equal(t, 1, empty)
+}
+
+func TestProd_ReturnsErrors(t *testing.T) {
+	_, err := Prod(failing([]int{1}))
+
+	assert.ErrorContains(t, err, "Invalid value")
+}
+
+func TestMean_ReturnsTheMeanOfElements(t *testing.T) {
+	mean, err := Mean(New([]int{1, 2, 3, 4}))
+
+	require.NoError(t, err)
+	assert.InDelta(t, 2.5, mean, 1e-9)
+}
+
+func TestMean_ReturnsAnErrorIfIteratorIsEmpty(t *testing.T) {
+	_, err := Mean(New([]int{}))
+	assert.ErrorContains(t, err, "iterator is empty")
+
+	_, err = Mean(failing([]int{1}))
+	assert.ErrorContains(t, err, "Invalid value")
+}
+
+func TestCollectStats_ReturnsStatistics(t *testing.T) {
+	stats, err := CollectStats(New([]int{2, 4, 4, 4, 5, 5, 7, 9}))
+
+	require.NoError(t, err)
+	assert.Equal(t, 8, stats.Count())
+	assert.InDelta(t, 2, stats.Min(), 1e-9)
+	assert.InDelta(t, 9, stats.Max(), 1e-9)
+	assert.InDelta(t, 5, stats.Mean(), 1e-9)
+	assert.InDelta(t, 4, stats.Variance(), 1e-9)
+	assert.InDelta(t, 32.0/7, stats.SampleVariance(), 1e-9)
+	assert.InDelta(t, 2, stats.StdDev(), 1e-9)
+}
+
+func TestStats_IsZeroWhenEmpty(t *testing.T) {
+	var stats Stats
+
+	assert.Equal(t, 0, stats.Count())
+	assert.Zero(t, stats.Min())
+	assert.Zero(t, stats.Max())
+	assert.Zero(t, stats.Mean())
+	assert.Zero(t, stats.Variance())
+	assert.Zero(t, stats.SampleVariance())
+}
+
+func TestStats_HandlesNegativeValues(t *testing.T) {
+	var stats Stats
+
+	stats.Add(-3)
+	stats.Add(-1)
+
+	assert.InDelta(t, -3, stats.Min(), 1e-9)
+	assert.InDelta(t, -1, stats.Max(), 1e-9)
+	assert.InDelta(t, -2, stats.Mean(), 1e-9)
+}
+
+func TestNewP2Quantile_ReturnsAnErrorOnInvalidQuantile(t *testing.T) {
+	_, err := NewP2Quantile(-0.1)
+	assert.ErrorContains(t, err, "quantile must be between 0 and 1")
+
+	_, err = NewP2Quantile(1.1)
+	assert.ErrorContains(t, err, "quantile must be between 0 and 1")
+}
+
+func TestP2Quantile_IsExactForFewValues(t *testing.T) {
+	q, err := NewP2Quantile(0.5)
+	require.NoError(t, err)
+
+	q.Add(3)
+	q.Add(1)
+	q.Add(2)
+
+	assert.Equal(t, 3, q.Count())
+	assert.InDelta(t, 2, q.Value(), 1e-9)
+}
+
+func TestP2Quantile_IsExactUpToFiveValues(t *testing.T) {
+	q, err := NewP2Quantile(0.9)
+	require.NoError(t, err)
+
+	for _, v := range []float64{4, 2, 1, 3} {
+		q.Add(v)
+	}
+
+	assert.InDelta(t, 4, q.Value(), 1e-9)
+
+	q.Add(5)
+
+	assert.Equal(t, 5, q.Count())
+	assert.InDelta(t, 5, q.Value(), 1e-9)
+}
+
+func TestQuantile_ApproximatesTheQuantile(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	values := make([]float64, 10_000)
+
+	for i := range values {
+		values[i] = rng.NormFloat64()*10 + 100
+	}
+
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	for _, p := range []float64{0.1, 0.5, 0.9, 0.99} {
+		q, err := Quantile(New(values), p)
+		require.NoError(t, err)
+
+		exact := sorted[int(math.Round(p*float64(len(sorted)-1)))]
+		assert.InDeltaf(t, exact, q, 0.5, "p=%v", p)
+	}
+}
+
+func TestQuantile_IsExactForTheBounds(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	values := make([]int, 1000)
+
+	for i := range values {
+		values[i] = i
+	}
+
+	rng.Shuffle(len(values), func(i, j int) { values[i], values[j] = values[j], values[i] })
+
+	lowest, err := Quantile(New(values), 0)
+	require.NoError(t, err)
+	assert.InDelta(t, 0, lowest, 1e-9)
+
+	highest, err := Quantile(New(values), 1)
+	require.NoError(t, err)
+	assert.InDelta(t, 999, highest, 1e-9)
+}
+
+func TestQuantile_ReturnsErrors(t *testing.T) {
+	_, err := Quantile(New([]int{}), 0.5)
+	assert.ErrorContains(t, err, "iterator is empty")
+
+	_, err = Quantile(New([]int{1}), 2)
+	assert.ErrorContains(t, err, "quantile must be between 0 and 1")
+
+	_, err = Quantile(failing([]int{1}), 0.5)
+	assert.ErrorContains(t, err, "Invalid value")
+}
@@ -0,0 +1,187 @@
+package betteriter
+
+import (
+	"errors"
+	"iter"
+	"sync"
+)
+
+// ErrTeeReused is returned by an iterator from Tee or TeeSync that is ranged over again.
+var ErrTeeReused = errors.New("tee iterator can only be ranged over once")
+
+// Tee splits an iterator into n independent iterators over the same elements. The source is consumed only once and
+// the shared buffer only retains the elements that haven't been consumed by the slowest reader yet, so a reader that
+// is never ranged over keeps everything buffered. The returned iterators must be consumed from a single goroutine, use
+// TeeSync for concurrent consumers.
+//
+// Each returned iterator is single-pass: once a pass over it ends, even if interrupted, its elements are dropped from
+// the buffer and ranging over it again only yields ErrTeeReused.
+func Tee[T any](iterator Iterator[T], n int) []Iterator[T] {
+	return tee(iterator, n, noopLocker{})
+}
+
+// TeeSync is like Tee, but the returned iterators can be consumed concurrently from different goroutines.
+func TeeSync[T any](iterator Iterator[T], n int) []Iterator[T] {
+	return tee(iterator, n, &sync.Mutex{})
+}
+
+func tee[T any](iterator Iterator[T], n int, mu sync.Locker) []Iterator[T] {
+	if n <= 0 {
+		return []Iterator[T]{}
+	}
+
+	return newTeeSource(iterator, n, mu).iterators()
+}
+
+type teeItem[T any] struct {
+	v   T
+	err error
+}
+
+type teeSource[T any] struct {
+	mu   sync.Locker
+	src  iter.Seq2[T, error]
+	next func() (T, error, bool)
+	stop func()
+
+	// buf holds the elements from absolute position base onwards.
+	buf  []teeItem[T]
+	base int
+
+	// pos is the absolute position of each reader, or -1 once the reader is done.
+	pos []int
+
+	started bool
+	done    bool
+}
+
+func newTeeSource[T any](iterator Iterator[T], n int, mu sync.Locker) *teeSource[T] {
+	return &teeSource[T]{
+		mu:      mu,
+		src:     iterator.it,
+		next:    nil,
+		stop:    nil,
+		buf:     nil,
+		base:    0,
+		pos:     make([]int, n),
+		started: false,
+		done:    false,
+	}
+}
+
+func (s *teeSource[T]) iterators() []Iterator[T] {
+	iterators := make([]Iterator[T], len(s.pos))
+	for i := range iterators {
+		iterators[i] = Iterator[T]{
+			it: func(yield func(T, error) bool) {
+				if s.released(i) {
+					var zero T
+					yield(zero, ErrTeeReused)
+
+					return
+				}
+
+				defer s.release(i)
+
+				for {
+					v, err, ok := s.get(i)
+					if !ok || !yield(v, err) {
+						return
+					}
+				}
+			},
+		}
+	}
+
+	return iterators
+}
+
+func (s *teeSource[T]) get(reader int) (T, error, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var zero T
+
+	p := s.pos[reader]
+	if p < 0 {
+		return zero, nil, false
+	}
+
+	if p-s.base == len(s.buf) {
+		if s.done {
+			return zero, nil, false
+		}
+
+		if !s.started {
+			s.next, s.stop = iter.Pull2(s.src)
+			s.started = true
+		}
+
+		v, err, ok := s.next()
+		if !ok {
+			s.close()
+
+			return zero, nil, false
+		}
+
+		s.buf = append(s.buf, teeItem[T]{v, err})
+	}
+
+	item := s.buf[p-s.base]
+	s.pos[reader]++
+	s.trim()
+
+	return item.v, item.err, true
+}
+
+func (s *teeSource[T]) released(reader int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.pos[reader] < 0
+}
+
+func (s *teeSource[T]) release(reader int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pos[reader] = -1
+	s.trim()
+}
+
+// trim drops the elements that every active reader has consumed, and closes the source once all readers are done.
+func (s *teeSource[T]) trim() {
+	low := -1
+
+	for _, p := range s.pos {
+		if p >= 0 && (low < 0 || p < low) {
+			low = p
+		}
+	}
+
+	if low < 0 {
+		s.buf = nil
+		s.close()
+
+		return
+	}
+
+	if drop := low - s.base; drop > 0 {
+		clear(s.buf[:drop])
+		s.buf = s.buf[drop:]
+		s.base = low
+	}
+}
+
+func (s *teeSource[T]) close() {
+	if s.started && !s.done {
+		s.stop()
+	}
+
+	s.done = true
+}
+
+type noopLocker struct{}
+
+func (noopLocker) Lock()   {}
+func (noopLocker) Unlock() {}
@@ -0,0 +1,149 @@
+package betteriter
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTee_ReturnsIndependentIterators(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5}
+	iterators := Tee(New(values), 3)
+
+	require.Len(t, iterators, 3)
+
+	for _, it := range iterators {
+		output, err := it.Collect()
+
+		require.NoError(t, err)
+		assert.Equal(t, values, output)
+	}
+}
+
+func TestTee_ConsumesTheSourceOnce(t *testing.T) {
+	pulled := 0
+	source := Map(New([]int{1, 2, 3}), func(i int) (int, error) {
+		pulled += 1
+
+		return i, nil
+	})
+
+	iterators := Tee(source, 2)
+
+	a, err := iterators[0].Collect()
+	require.NoError(t, err)
+
+	b, err := iterators[1].Collect()
+	require.NoError(t, err)
+
+	assert.Equal(t, a, b)
+	assert.Equal(t, 3, pulled)
+}
+
+func TestTee_IsLazy(t *testing.T) {
+	values := []int{1, 2, 3}
+	source := Map(New(values), func(i int) (int, error) {
+		assert.LessOrEqualf(t, i, 2, "Source was pulled with unexpected value: %d", i)
+
+		return i, nil
+	})
+
+	for _, it := range Tee(source, 2) {
+		for v := range it.it {
+			if v == 2 {
+				break
+			}
+		}
+	}
+}
+
+func TestTee_ForwardsErrors(t *testing.T) {
+	source := Map(New([]int{1, 2}), func(i int) (int, error) {
+		return 0, errors.New("Invalid value")
+	})
+
+	for _, it := range Tee(source, 2) {
+		output, err := it.Collect()
+
+		assert.Empty(t, output)
+		assert.ErrorContains(t, err, "Invalid value")
+	}
+}
+
+func TestTee_ReturnsAnErrorWhenAnIteratorIsReused(t *testing.T) {
+	iterators := Tee(New([]int{1, 2, 3}), 2)
+
+	output, err := iterators[0].Collect()
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, output)
+
+	output, err = iterators[0].Collect()
+	assert.Empty(t, output)
+	require.ErrorIs(t, err, ErrTeeReused)
+
+	for range iterators[1].it {
+		break
+	}
+
+	output, err = iterators[1].Collect()
+	assert.Empty(t, output)
+	assert.ErrorIs(t, err, ErrTeeReused)
+}
+
+func TestTee_ReturnsNothingIfNIsZero(t *testing.T) {
+	assert.Empty(t, Tee(New([]int{1}), 0))
+}
+
+func TestTee_OnlyBuffersElementsNotConsumedByTheSlowestReader(t *testing.T) {
+	src := newTeeSource(New([]int{1, 2, 3, 4, 5}), 2, noopLocker{})
+
+	for range 4 {
+		_, _, ok := src.get(0)
+		require.True(t, ok)
+	}
+
+	assert.Len(t, src.buf, 4)
+
+	for range 3 {
+		_, _, ok := src.get(1)
+		require.True(t, ok)
+	}
+
+	assert.Len(t, src.buf, 1)
+	assert.Equal(t, 3, src.base)
+
+	// Once a reader is done, it no longer holds the buffer back
+	src.release(1)
+
+	assert.Empty(t, src.buf)
+	assert.Equal(t, 4, src.base)
+}
+
+func TestTeeSync_SupportsConcurrentConsumers(t *testing.T) {
+	n := fake.IntBetween(100, 10_000)
+	iterators := TeeSync(NewRepeatN(1, n), 8)
+
+	sums := make([]int, len(iterators))
+
+	var wg sync.WaitGroup
+	for i, it := range iterators {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for v := range it.it {
+				sums[i] += v
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	for _, sum := range sums {
+		assert.Equal(t, n, sum)
+	}
+}
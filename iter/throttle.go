@@ -0,0 +1,125 @@
+package betteriter
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// Throttle limits the pace at which elements are yielded with a token bucket that holds up to burst tokens and is
+// refilled at rate tokens per second. Each element consumes a token, waiting for one to be available if needed.
+func Throttle[T any](iterator Iterator[T], rate float64, burst int, opts ...TimingConfig) Iterator[T] {
+	clock := newTimingOptions(opts).Clock
+
+	return Iterator[T]{
+		it: func(yield func(T, error) bool) {
+			if rate <= 0 || burst <= 0 {
+				var zero T
+				yield(zero, errors.New("rate and burst must be positive"))
+
+				return
+			}
+
+			tokens := float64(burst)
+			last := clock.Now()
+
+			refill := func() {
+				now := clock.Now()
+				tokens = math.Min(float64(burst), tokens+now.Sub(last).Seconds()*rate)
+				last = now
+			}
+
+			for v, err := range iterator.it {
+				refill()
+
+				if tokens < 1 {
+					wait := math.Ceil((1 - tokens) / rate * float64(time.Second))
+					clock.Sleep(time.Duration(wait))
+					refill()
+				}
+
+				tokens = math.Max(tokens-1, 0)
+
+				if !yield(v, err) {
+					return
+				}
+			}
+		},
+		size: iterator.size,
+	}
+}
+
+// Debounce only yields the elements that are followed by a quiet period of at least d before the next one, plus the
+// last element. Since iterators are pulled, an element is held back until the next one arrives. Errors are yielded
+// right away, after flushing the pending element.
+func Debounce[T any](iterator Iterator[T], d time.Duration, opts ...TimingConfig) Iterator[T] {
+	clock := newTimingOptions(opts).Clock
+
+	return Iterator[T]{
+		it: func(yield func(T, error) bool) {
+			var pending T
+			var pendingAt time.Time
+			hasPending := false
+
+			for v, err := range iterator.it {
+				now := clock.Now()
+
+				if err != nil {
+					if hasPending && !yield(pending, nil) {
+						return
+					}
+
+					hasPending = false
+
+					if !yield(v, err) {
+						return
+					}
+
+					continue
+				}
+
+				if hasPending && now.Sub(pendingAt) >= d && !yield(pending, nil) {
+					return
+				}
+
+				pending, pendingAt, hasPending = v, now, true
+			}
+
+			if hasPending {
+				yield(pending, nil)
+			}
+		},
+	}
+}
+
+// SampleEvery yields the first element of every interval and drops the others. Errors are always yielded.
+func SampleEvery[T any](iterator Iterator[T], interval time.Duration, opts ...TimingConfig) Iterator[T] {
+	clock := newTimingOptions(opts).Clock
+
+	return Iterator[T]{
+		it: func(yield func(T, error) bool) {
+			var next time.Time
+
+			for v, err := range iterator.it {
+				if err != nil {
+					if !yield(v, err) {
+						return
+					}
+
+					continue
+				}
+
+				now := clock.Now()
+				if now.Before(next) {
+					continue
+				}
+
+				next = now.Add(interval)
+
+				if !yield(v, nil) {
+					return
+				}
+			}
+		},
+	}
+}
@@ -0,0 +1,114 @@
+package betteriter
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThrottle_AllowsABurstThenPacesElements(t *testing.T) {
+	clock := newFakeClock()
+	start := clock.Now()
+
+	times := make([]time.Duration, 0)
+
+	for range Throttle(NewRepeatN(1, 6), 2, 3, WithClock(clock)).it {
+		times = append(times, clock.Now().Sub(start))
+	}
+
+	expected := []time.Duration{
+		0,
+		0,
+		0,
+		500 * time.Millisecond,
+		1000 * time.Millisecond,
+		1500 * time.Millisecond,
+	}
+	assert.Equal(t, expected, times)
+}
+
+func TestThrottle_RefillsTokensWhileIdle(t *testing.T) {
+	clock := newFakeClock()
+	delays := []time.Duration{0, 0, time.Second, 0}
+
+	output, err := Throttle(advancing(clock, []int{1, 2, 3, 4}, delays), 2, 2, WithClock(clock)).Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4}, output)
+	assert.Empty(t, clock.slept)
+}
+
+func TestThrottle_ReturnsAnErrorOnInvalidParameters(t *testing.T) {
+	output, err := Throttle(New([]int{1}), 0, 1).Collect()
+	assert.ErrorContains(t, err, "rate and burst must be positive")
+	assert.Empty(t, output)
+
+	output, err = Throttle(New([]int{1}), 1, 0).Collect()
+	assert.ErrorContains(t, err, "rate and burst must be positive")
+	assert.Empty(t, output)
+}
+
+func TestDebounce_OnlyYieldsElementsFollowedByAQuietPeriod(t *testing.T) {
+	clock := newFakeClock()
+	values := []int{1, 2, 3, 4, 5, 6}
+	delays := []time.Duration{
+		0,
+		10 * time.Millisecond,
+		10 * time.Millisecond,
+		time.Second,
+		10 * time.Millisecond,
+		time.Second,
+	}
+
+	output, err := Debounce(advancing(clock, values, delays), 100*time.Millisecond, WithClock(clock)).Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{3, 5, 6}, output)
+}
+
+func TestDebounce_ForwardsErrors(t *testing.T) {
+	source := Map(New([]int{1, 2}), func(i int) (int, error) {
+		if i == 2 {
+			return 0, errors.New("Invalid value")
+		}
+
+		return i, nil
+	})
+
+	output := make([]int, 0)
+
+	var err error
+	for v, e := range Debounce(source, time.Second, WithClock(newFakeClock())).it {
+		if e != nil {
+			err = e
+
+			break
+		}
+
+		output = append(output, v)
+	}
+
+	assert.Equal(t, []int{1}, output)
+	assert.ErrorContains(t, err, "Invalid value")
+}
+
+func TestSampleEvery_YieldsTheFirstElementOfEveryInterval(t *testing.T) {
+	clock := newFakeClock()
+	values := []int{1, 2, 3, 4, 5, 6}
+	delays := []time.Duration{
+		0,
+		40 * time.Millisecond,
+		40 * time.Millisecond,
+		40 * time.Millisecond,
+		40 * time.Millisecond,
+		200 * time.Millisecond,
+	}
+
+	output, err := SampleEvery(advancing(clock, values, delays), 100*time.Millisecond, WithClock(clock)).Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 4, 6}, output)
+}
@@ -0,0 +1,121 @@
+package betteriter
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Inspect calls f with every element and error before passing them through.
+func Inspect[T any](iterator Iterator[T], f func(T, error)) Iterator[T] {
+	inner := func(yield func(T, error) bool) {
+		for v, err := range iterator.it {
+			f(v, err)
+
+			if !yield(v, err) {
+				return
+			}
+		}
+	}
+
+	return Iterator[T]{
+		it:   inner,
+		size: iterator.size,
+	}
+}
+
+// StageMetrics holds the counters of a traced stage. They are updated as the stage is consumed and can be read at
+// any time, from any goroutine.
+type StageMetrics struct {
+	name     string
+	count    atomic.Int64
+	errors   atomic.Int64
+	passes   atomic.Int64
+	duration atomic.Int64
+}
+
+// Name returns the name of the stage.
+func (m *StageMetrics) Name() string {
+	return m.name
+}
+
+// Count returns the number of elements yielded by the stage, including errors.
+func (m *StageMetrics) Count() int64 {
+	return m.count.Load()
+}
+
+// Errors returns the number of errors yielded by the stage.
+func (m *StageMetrics) Errors() int64 {
+	return m.errors.Load()
+}
+
+// Passes returns the number of passes over the stage that have ended, whether it was fully consumed or interrupted.
+func (m *StageMetrics) Passes() int64 {
+	return m.passes.Load()
+}
+
+// Duration returns the total time spent in the passes counted by Passes, including the time spent by consumers. A
+// pass still in progress isn't included.
+func (m *StageMetrics) Duration() time.Duration {
+	return time.Duration(m.duration.Load())
+}
+
+// Trace logs a debug event for every element going through the stage, and a summary once the stage is done, using
+// the global logger set up by logging.ConfigureLogger. The returned metrics are updated as the stage is consumed.
+func Trace[T any](iterator Iterator[T], name string, opts ...TimingConfig) (Iterator[T], *StageMetrics) {
+	clock := newTimingOptions(opts).Clock
+	metrics := &StageMetrics{name: name} //nolint:exhaustruct  // Counters start at zero
+
+	inner := func(yield func(T, error) bool) {
+		start := clock.Now()
+
+		var count, errs int64
+
+		defer func() {
+			elapsed := clock.Now().Sub(start)
+
+			metrics.passes.Add(1)
+			metrics.duration.Add(int64(elapsed))
+
+			log.Debug().
+				Str("stage", name).
+				Int64("count", count).
+				Int64("errors", errs).
+				Dur("duration", elapsed).
+				Msg("stage done")
+		}()
+
+		for v, err := range iterator.it {
+			count++
+			metrics.count.Add(1)
+
+			if err != nil {
+				errs++
+				metrics.errors.Add(1)
+			}
+
+			// Checking the level first avoids boxing every value when debug logs are disabled.
+			if event := log.Debug(); event.Enabled() {
+				event = event.Str("stage", name).Int64("index", count-1)
+
+				if err != nil {
+					event = event.Err(err)
+				} else {
+					event = event.Interface("value", v)
+				}
+
+				event.Msg("element")
+			}
+
+			if !yield(v, err) {
+				return
+			}
+		}
+	}
+
+	return Iterator[T]{
+		it:   inner,
+		size: iterator.size,
+	}, metrics
+}
@@ -0,0 +1,142 @@
+package betteriter
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// captureLogs redirects the global logger to a buffer for the duration of the test.
+func captureLogs(t *testing.T) *bytes.Buffer {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	logger := log.Logger
+	level := zerolog.GlobalLevel()
+
+	log.Logger = zerolog.New(buf)
+	zerolog.SetGlobalLevel(zerolog.DebugLevel)
+
+	t.Cleanup(func() {
+		log.Logger = logger
+		zerolog.SetGlobalLevel(level)
+	})
+
+	return buf
+}
+
+func parseLogs(t *testing.T, buf *bytes.Buffer) []jsonMap {
+	t.Helper()
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	events := make([]jsonMap, len(lines))
+
+	for i, l := range lines {
+		require.NoError(t, json.Unmarshal([]byte(l), &events[i]))
+	}
+
+	return events
+}
+
+type jsonMap map[string]any
+
+func TestInspect_CallsTheInspectorWithEveryElement(t *testing.T) {
+	seen := make([]int, 0)
+
+	output, err := Inspect(New([]int{1, 2, 3}), func(v int, err error) {
+		assert.NoError(t, err)
+
+		seen = append(seen, v)
+	}).Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, output)
+	assert.Equal(t, []int{1, 2, 3}, seen)
+}
+
+func TestTrace_LogsElementsAndSummary(t *testing.T) {
+	buf := captureLogs(t)
+
+	clock := newFakeClock()
+	source := advancing(clock, []int{1, 2}, []time.Duration{time.Second, time.Second})
+
+	it, metrics := Trace(source, "squares", WithClock(clock))
+
+	output, err := it.Collect()
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, output)
+
+	events := parseLogs(t, buf)
+	require.Len(t, events, 3)
+
+	assert.Equal(t, "squares", events[0]["stage"])
+	assert.Equal(t, "element", events[0]["message"])
+	assert.InDelta(t, 0, events[0]["index"], 0)
+	assert.InDelta(t, 1, events[0]["value"], 0)
+
+	assert.InDelta(t, 1, events[1]["index"], 0)
+	assert.InDelta(t, 2, events[1]["value"], 0)
+
+	assert.Equal(t, "stage done", events[2]["message"])
+	assert.InDelta(t, 2, events[2]["count"], 0)
+	assert.InDelta(t, 0, events[2]["errors"], 0)
+	assert.InDelta(t, 2000, events[2]["duration"], 0)
+
+	assert.Equal(t, "squares", metrics.Name())
+	assert.Equal(t, int64(2), metrics.Count())
+	assert.Equal(t, int64(0), metrics.Errors())
+	assert.Equal(t, int64(1), metrics.Passes())
+	assert.Equal(t, 2*time.Second, metrics.Duration())
+}
+
+func TestTrace_CountsErrors(t *testing.T) {
+	buf := captureLogs(t)
+
+	source := Map(New([]int{1, 2}), func(i int) (int, error) {
+		if i == 2 {
+			return 0, errors.New("Invalid value")
+		}
+
+		return i, nil
+	})
+
+	it, metrics := Trace(source, "failing")
+
+	_, err := it.Collect()
+	require.Error(t, err)
+
+	events := parseLogs(t, buf)
+	require.Len(t, events, 3)
+
+	assert.Equal(t, "Invalid value", events[1]["error"])
+	assert.InDelta(t, 1, events[2]["errors"], 0)
+
+	assert.Equal(t, int64(2), metrics.Count())
+	assert.Equal(t, int64(1), metrics.Errors())
+	assert.Equal(t, int64(1), metrics.Passes())
+}
+
+func TestTrace_ReportsInterruptedPasses(t *testing.T) {
+	buf := captureLogs(t)
+
+	it, metrics := Trace(NewRepeat(1), "infinite")
+
+	for range it.it {
+		break
+	}
+
+	events := parseLogs(t, buf)
+	require.Len(t, events, 2)
+
+	assert.InDelta(t, 1, events[1]["count"], 0)
+	assert.Equal(t, int64(1), metrics.Count())
+	assert.Equal(t, int64(1), metrics.Passes())
+}
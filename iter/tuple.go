@@ -0,0 +1,85 @@
+package betteriter
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Tuple is a pair of values. It's encoded in JSON as a 2-element array.
+type Tuple[T any, U any] struct {
+	A T
+	B U
+}
+
+// Unpack returns the values of the tuple.
+func (t Tuple[T, U]) Unpack() (T, U) {
+	return t.A, t.B
+}
+
+func (t Tuple[T, U]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.A, t.B})
+}
+
+func (t *Tuple[T, U]) UnmarshalJSON(data []byte) error {
+	return unmarshalArray(data, &t.A, &t.B)
+}
+
+// Tuple3 is a triple of values. It's encoded in JSON as a 3-element array.
+type Tuple3[T any, U any, V any] struct {
+	A T
+	B U
+	C V
+}
+
+// Unpack returns the values of the tuple.
+func (t Tuple3[T, U, V]) Unpack() (T, U, V) {
+	return t.A, t.B, t.C
+}
+
+func (t Tuple3[T, U, V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.A, t.B, t.C})
+}
+
+func (t *Tuple3[T, U, V]) UnmarshalJSON(data []byte) error {
+	return unmarshalArray(data, &t.A, &t.B, &t.C)
+}
+
+// Tuple4 is a quadruple of values. It's encoded in JSON as a 4-element array.
+type Tuple4[T any, U any, V any, W any] struct {
+	A T
+	B U
+	C V
+	D W
+}
+
+// Unpack returns the values of the tuple.
+func (t Tuple4[T, U, V, W]) Unpack() (T, U, V, W) {
+	return t.A, t.B, t.C, t.D
+}
+
+func (t Tuple4[T, U, V, W]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.A, t.B, t.C, t.D})
+}
+
+func (t *Tuple4[T, U, V, W]) UnmarshalJSON(data []byte) error {
+	return unmarshalArray(data, &t.A, &t.B, &t.C, &t.D)
+}
+
+func unmarshalArray(data []byte, targets ...any) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return fmt.Errorf("failed to unmarshal tuple: %w", err)
+	}
+
+	if len(items) != len(targets) {
+		return fmt.Errorf("expected an array of %d elements, got %d", len(targets), len(items))
+	}
+
+	for i, item := range items {
+		if err := json.Unmarshal(item, targets[i]); err != nil {
+			return fmt.Errorf("failed to unmarshal tuple element %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package betteriter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTuple_Unpack(t *testing.T) {
+	a, b := Tuple[int, string]{1, "one"}.Unpack()
+	assert.Equal(t, 1, a)
+	assert.Equal(t, "one", b)
+
+	a, b, c := Tuple3[int, string, bool]{1, "one", true}.Unpack()
+	assert.Equal(t, 1, a)
+	assert.Equal(t, "one", b)
+	assert.True(t, c)
+
+	a, b, c, d := Tuple4[int, string, bool, float64]{1, "one", true, 1.5}.Unpack()
+	assert.Equal(t, 1, a)
+	assert.Equal(t, "one", b)
+	assert.True(t, c)
+	assert.InDelta(t, 1.5, d, 0)
+}
+
+func TestTuple_MarshalsAsAnArray(t *testing.T) {
+	data, err := json.Marshal(Tuple[int, string]{1, "one"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `[1, "one"]`, string(data))
+
+	data, err = json.Marshal([]Tuple3[int, string, bool]{{1, "one", true}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `[[1, "one", true]]`, string(data))
+
+	data, err = json.Marshal(Tuple4[int, string, bool, []int]{1, "one", true, []int{2}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `[1, "one", true, [2]]`, string(data))
+}
+
+func TestTuple_UnmarshalsFromAnArray(t *testing.T) {
+	var t2 Tuple[int, string]
+	require.NoError(t, json.Unmarshal([]byte(`[1, "one"]`), &t2))
+	assert.Equal(t, Tuple[int, string]{1, "one"}, t2)
+
+	var t3 []Tuple3[int, string, bool]
+	require.NoError(t, json.Unmarshal([]byte(`[[1, "one", true]]`), &t3))
+	assert.Equal(t, []Tuple3[int, string, bool]{{1, "one", true}}, t3)
+
+	var t4 Tuple4[int, string, bool, []int]
+	require.NoError(t, json.Unmarshal([]byte(`[1, "one", true, [2]]`), &t4))
+	assert.Equal(t, Tuple4[int, string, bool, []int]{1, "one", true, []int{2}}, t4)
+}
+
+func TestTuple_ReturnsAnErrorOnInvalidJSON(t *testing.T) {
+	var tuple Tuple[int, string]
+
+	testCases := []struct {
+		msg      string
+		data     string
+		expected string
+	}{
+		{"not an array", `{"A": 1}`, "failed to unmarshal tuple"},
+		{"too short", `[1]`, "expected an array of 2 elements, got 1"},
+		{"too long", `[1, "one", 2]`, "expected an array of 2 elements, got 3"},
+		{"invalid element", `["one", "one"]`, "failed to unmarshal tuple element 0"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			err := json.Unmarshal([]byte(tc.data), &tuple)
+
+			assert.ErrorContains(t, err, tc.expected)
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package betteriter
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// WalkEntry is an entry of a file tree.
+type WalkEntry struct {
+	// Path is the path of the entry, including the root of the walk.
+	Path string
+	// Entry is the entry itself. It can be nil if the entry couldn't be read.
+	Entry fs.DirEntry
+	// Depth is the depth of the entry, starting at 0 for the root.
+	Depth int
+}
+
+// WalkOptions is the configuration of a file tree walk.
+type WalkOptions struct {
+	// Include restricts the yielded entries to the ones matching at least one of the patterns. Directories are still
+	// walked through when they don't match.
+	Include []string
+	// Exclude skips the entries matching any of the patterns, including the content of the matching directories.
+	Exclude []string
+	// MaxDepth is the maximum depth of the yielded entries, or -1 for no limit.
+	MaxDepth int
+}
+
+// WalkConfig is a function to change the configuration of a file tree walk.
+type WalkConfig func(*WalkOptions)
+
+// WithInclude adds patterns of entries to include. Patterns use the syntax of path.Match and are matched against the
+// path relative to the root if they contain a slash, or against the name of the entry otherwise.
+func WithInclude(patterns ...string) WalkConfig {
+	return func(o *WalkOptions) {
+		o.Include = append(o.Include, patterns...)
+	}
+}
+
+// WithExclude adds patterns of entries to exclude, using the same syntax as WithInclude.
+func WithExclude(patterns ...string) WalkConfig {
+	return func(o *WalkOptions) {
+		o.Exclude = append(o.Exclude, patterns...)
+	}
+}
+
+// WithMaxDepth limits the depth of the walk.
+func WithMaxDepth(depth int) WalkConfig {
+	return func(o *WalkOptions) {
+		o.MaxDepth = depth
+	}
+}
+
+// WalkFS lazily walks the file tree of fsys rooted at root, in lexical order. Errors reading an entry are yielded
+// along with the entry instead of aborting the walk.
+func WalkFS(fsys fs.FS, root string, opts ...WalkConfig) Iterator[WalkEntry] {
+	return walk(fsys, root, func(p string) string { return p }, opts)
+}
+
+// WalkDir is like WalkFS, for the file tree of the operating system rooted at root. Paths use the separator of the
+// operating system.
+func WalkDir(root string, opts ...WalkConfig) Iterator[WalkEntry] {
+	toOSPath := func(p string) string {
+		return filepath.Join(root, filepath.FromSlash(p))
+	}
+
+	return walk(os.DirFS(root), ".", toOSPath, opts)
+}
+
+func walk(fsys fs.FS, root string, toPath func(string) string, opts []WalkConfig) Iterator[WalkEntry] {
+	options := WalkOptions{
+		Include:  nil,
+		Exclude:  nil,
+		MaxDepth: -1,
+	}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	inner := func(yield func(WalkEntry, error) bool) {
+		for _, p := range slices.Concat(options.Include, options.Exclude) {
+			if _, err := path.Match(p, ""); err != nil {
+				yield(WalkEntry{}, fmt.Errorf("invalid pattern %q: %w", p, err))
+
+				return
+			}
+		}
+
+		_ = fs.WalkDir(fsys, root, func(p string, d fs.DirEntry, err error) error {
+			rel := relativePath(root, p)
+			depth := pathDepth(rel)
+			entry := WalkEntry{toPath(p), d, depth}
+
+			if err != nil {
+				if !yield(entry, err) {
+					return fs.SkipAll
+				}
+
+				return nil
+			}
+
+			if matchAny(options.Exclude, rel) {
+				return skip(d)
+			}
+
+			if options.MaxDepth >= 0 && depth > options.MaxDepth {
+				return skip(d)
+			}
+
+			if (len(options.Include) == 0 || matchAny(options.Include, rel)) && !yield(entry, nil) {
+				return fs.SkipAll
+			}
+
+			if d.IsDir() && depth == options.MaxDepth {
+				return fs.SkipDir
+			}
+
+			return nil
+		})
+	}
+
+	return Iterator[WalkEntry]{
+		it: inner,
+	}
+}
+
+// skip skips the entry, and its content if it's a directory.
+func skip(d fs.DirEntry) error {
+	if d.IsDir() {
+		return fs.SkipDir
+	}
+
+	return nil
+}
+
+func relativePath(root string, p string) string {
+	if p == root {
+		return "."
+	}
+
+	if root == "." {
+		return p
+	}
+
+	return strings.TrimPrefix(p, root+"/")
+}
+
+func pathDepth(rel string) int {
+	if rel == "." {
+		return 0
+	}
+
+	return strings.Count(rel, "/") + 1
+}
+
+func matchAny(patterns []string, rel string) bool {
+	name := path.Base(rel)
+
+	for _, p := range patterns {
+		target := name
+		if strings.Contains(p, "/") {
+			target = rel
+		}
+
+		// Patterns have been validated before walking
+		if ok, _ := path.Match(p, target); ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,187 @@
+package betteriter
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fixtures() fstest.MapFS {
+	return fstest.MapFS{
+		"a.txt":             {Data: []byte("a")},
+		"b.json":            {Data: []byte("{}")},
+		"sub/c.txt":         {Data: []byte("c")},
+		"sub/deep/d.txt":    {Data: []byte("d")},
+		"vendor/e.txt":      {Data: []byte("e")},
+		"vendor/more/f.txt": {Data: []byte("f")},
+	}
+}
+
+func walkedPaths(t *testing.T, it Iterator[WalkEntry]) []string {
+	t.Helper()
+
+	entries, err := it.Collect()
+	require.NoError(t, err)
+
+	paths := make([]string, len(entries))
+	for i, e := range entries {
+		paths[i] = e.Path
+	}
+
+	return paths
+}
+
+type failingFS struct {
+	fstest.MapFS
+
+	bad string
+}
+
+func (f failingFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if name == f.bad {
+		return nil, errors.New("permission denied")
+	}
+
+	return f.MapFS.ReadDir(name)
+}
+
+func TestWalkFS_YieldsAllEntries(t *testing.T) {
+	paths := walkedPaths(t, WalkFS(fixtures(), "."))
+
+	expected := []string{
+		".",
+		"a.txt",
+		"b.json",
+		"sub",
+		"sub/c.txt",
+		"sub/deep",
+		"sub/deep/d.txt",
+		"vendor",
+		"vendor/e.txt",
+		"vendor/more",
+		"vendor/more/f.txt",
+	}
+	assert.Equal(t, expected, paths)
+}
+
+func TestWalkFS_ReportsDepth(t *testing.T) {
+	entries, err := WalkFS(fixtures(), "sub").Collect()
+	require.NoError(t, err)
+
+	depths := make(map[string]int)
+	for _, e := range entries {
+		depths[e.Path] = e.Depth
+	}
+
+	expected := map[string]int{
+		"sub":            0,
+		"sub/c.txt":      1,
+		"sub/deep":       1,
+		"sub/deep/d.txt": 2,
+	}
+	assert.Equal(t, expected, depths)
+}
+
+func TestWalkFS_FiltersEntries(t *testing.T) {
+	testCases := []struct {
+		msg      string
+		opts     []WalkConfig
+		expected []string
+	}{
+		{
+			"include by name",
+			[]WalkConfig{WithInclude("*.txt")},
+			[]string{"a.txt", "sub/c.txt", "sub/deep/d.txt", "vendor/e.txt", "vendor/more/f.txt"},
+		},
+		{
+			"include by path",
+			[]WalkConfig{WithInclude("sub/*")},
+			[]string{"sub/c.txt", "sub/deep"},
+		},
+		{
+			"exclude directory",
+			[]WalkConfig{WithInclude("*.txt"), WithExclude("vendor")},
+			[]string{"a.txt", "sub/c.txt", "sub/deep/d.txt"},
+		},
+		{
+			"max depth",
+			[]WalkConfig{WithMaxDepth(1)},
+			[]string{".", "a.txt", "b.json", "sub", "vendor"},
+		},
+		{
+			"max depth with include",
+			[]WalkConfig{WithMaxDepth(2), WithInclude("*.txt")},
+			[]string{"a.txt", "sub/c.txt", "vendor/e.txt"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			assert.Equal(t, tc.expected, walkedPaths(t, WalkFS(fixtures(), ".", tc.opts...)))
+		})
+	}
+}
+
+func TestWalkFS_ReturnsAnErrorOnInvalidPattern(t *testing.T) {
+	output, err := WalkFS(fixtures(), ".", WithExclude("[")).Collect()
+
+	assert.Empty(t, output)
+	assert.ErrorContains(t, err, `invalid pattern "["`)
+}
+
+func TestWalkFS_YieldsErrorsWithoutAbortingTheWalk(t *testing.T) {
+	fsys := failingFS{fixtures(), "sub"}
+
+	paths := make([]string, 0)
+	failed := make([]string, 0)
+
+	for e, err := range WalkFS(fsys, ".").it {
+		if err != nil {
+			assert.ErrorContains(t, err, "permission denied")
+
+			failed = append(failed, e.Path)
+
+			continue
+		}
+
+		paths = append(paths, e.Path)
+	}
+
+	assert.Equal(t, []string{"sub"}, failed)
+	assert.Contains(t, paths, "vendor/more/f.txt")
+	assert.NotContains(t, paths, "sub/c.txt")
+}
+
+func TestWalkFS_IsLazy(t *testing.T) {
+	fsys := failingFS{fixtures(), "vendor"}
+
+	for e, err := range WalkFS(fsys, ".").it {
+		require.NoError(t, err)
+
+		if e.Path == "sub" {
+			break
+		}
+	}
+}
+
+func TestWalkDir_WalksTheOperatingSystemFileTree(t *testing.T) {
+	root := t.TempDir()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "sub"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "a.txt"), []byte("a"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "sub", "b.txt"), []byte("b"), 0o600))
+
+	paths := walkedPaths(t, WalkDir(root, WithInclude("*.txt")))
+
+	expected := []string{
+		filepath.Join(root, "a.txt"),
+		filepath.Join(root, "sub", "b.txt"),
+	}
+	assert.Equal(t, expected, paths)
+}
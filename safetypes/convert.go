@@ -30,18 +30,44 @@ func AsOptionValue[T any, E error](res result.Result[T, E]) option.Option[T] {
 		return option.Some(res.Unwrap())
 	}
 
-	var v T
-
-	return option.Of(v)
+	return option.NoneOf[T]()
 }
 
 // AsOptionErr converts a result.Result to an option.Some when res is result.Err or option.None when res is result.Ok.
 func AsOptionErr[T any, E error](res result.Result[T, E]) option.Option[E] {
 	if res.IsErr() {
-		return option.Of(res.UnwrapErr())
+		return option.FromNillable(res.UnwrapErr())
+	}
+
+	return option.NoneOf[E]()
+}
+
+// TransposeOption transposes an Option of a Result into a Result of an Option. None is mapped to Ok(None), Some(Ok(v))
+// to Ok(Some(v)) and Some(Err(e)) to Err(e).
+func TransposeOption[T any, E error](opt option.Option[result.Result[T, E]]) result.Result[option.Option[T], E] {
+	if opt.IsNone() {
+		return result.Ok[option.Option[T], E](option.NoneOf[T]())
+	}
+
+	res := opt.Unwrap()
+	if res.IsErr() {
+		return result.Err[option.Option[T]](res.UnwrapErr())
 	}
 
-	var v E
+	return result.Ok[option.Option[T], E](option.Some(res.Unwrap()))
+}
+
+// TransposeResult transposes a Result of an Option into an Option of a Result. Ok(None) is mapped to None,
+// Ok(Some(v)) to Some(Ok(v)) and Err(e) to Some(Err(e)).
+func TransposeResult[T any, E error](res result.Result[option.Option[T], E]) option.Option[result.Result[T, E]] {
+	if res.IsErr() {
+		return option.Some(result.Err[T](res.UnwrapErr()))
+	}
+
+	opt := res.Unwrap()
+	if opt.IsNone() {
+		return option.NoneOf[result.Result[T, E]]()
+	}
 
-	return option.Of(v)
+	return option.Some(result.Ok[T, E](opt.Unwrap()))
 }
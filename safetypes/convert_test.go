@@ -26,7 +26,7 @@ func TestAsOkOr(t *testing.T) {
 	})
 
 	t.Run("none", func(t *testing.T) {
-		n := option.Of(0)
+		n := option.NoneOf[int]()
 		err := errors.New(fake.RandomStringWithLength(8))
 
 		expected := result.Of(0, err)
@@ -52,7 +52,7 @@ func TestAsOkOrElse(t *testing.T) {
 	})
 
 	t.Run("none", func(t *testing.T) {
-		n := option.Of(0)
+		n := option.NoneOf[int]()
 		err := errors.New(fake.RandomStringWithLength(8))
 
 		f := func() error {
@@ -79,7 +79,7 @@ func TestAsOptionValue(t *testing.T) {
 		err := errors.New(fake.RandomStringWithLength(8))
 		e := result.Of(0, err)
 
-		expected := option.Of(0)
+		expected := option.NoneOf[int]()
 
 		assert.Equal(t, expected, AsOptionValue(e))
 	})
@@ -90,7 +90,7 @@ func TestAsOptionErr(t *testing.T) {
 		value := fake.Int()
 		o := result.Ok[int, error](value)
 
-		expected := option.Of((error)(nil))
+		expected := option.NoneOf[error]()
 
 		assert.Equal(t, expected, AsOptionErr(o))
 	})
@@ -99,8 +99,71 @@ func TestAsOptionErr(t *testing.T) {
 		err := errors.New(fake.RandomStringWithLength(8))
 		e := result.Of(0, err)
 
-		expected := option.Of(err)
+		expected := option.Some(err)
 
 		assert.Equal(t, expected, AsOptionErr(e))
 	})
 }
+
+func TestTransposeOption(t *testing.T) {
+	t.Run("none", func(t *testing.T) {
+		n := option.NoneOf[result.Result[int, error]]()
+
+		expected := result.Ok[option.Option[int], error](option.NoneOf[int]())
+
+		assert.Equal(t, expected, TransposeOption(n))
+	})
+
+	t.Run("some ok", func(t *testing.T) {
+		value := fake.Int()
+		s := option.Some(result.Ok[int, error](value))
+
+		expected := result.Ok[option.Option[int], error](option.Some(value))
+
+		assert.Equal(t, expected, TransposeOption(s))
+	})
+
+	t.Run("some err", func(t *testing.T) {
+		err := errors.New(fake.RandomStringWithLength(8))
+		s := option.Some(result.Err[int](err))
+
+		expected := result.Err[option.Option[int]](err)
+
+		assert.Equal(t, expected, TransposeOption(s))
+	})
+}
+
+func TestTransposeResult(t *testing.T) {
+	t.Run("ok none", func(t *testing.T) {
+		o := result.Ok[option.Option[int], error](option.NoneOf[int]())
+
+		expected := option.NoneOf[result.Result[int, error]]()
+
+		assert.Equal(t, expected, TransposeResult(o))
+	})
+
+	t.Run("ok some", func(t *testing.T) {
+		value := fake.Int()
+		o := result.Ok[option.Option[int], error](option.Some(value))
+
+		expected := option.Some(result.Ok[int, error](value))
+
+		assert.Equal(t, expected, TransposeResult(o))
+	})
+
+	t.Run("err", func(t *testing.T) {
+		err := errors.New(fake.RandomStringWithLength(8))
+		e := result.Err[option.Option[int]](err)
+
+		expected := option.Some(result.Err[int](err))
+
+		assert.Equal(t, expected, TransposeResult(e))
+	})
+}
+
+func TestTranspose_RoundTrips(t *testing.T) {
+	value := fake.Int()
+	s := option.Some(result.Ok[int, error](value))
+
+	assert.Equal(t, s, TransposeResult(TransposeOption(s)))
+}
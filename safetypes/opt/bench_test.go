@@ -0,0 +1,130 @@
+package opt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mathieu-lemay/go-sandbox/safetypes/option"
+)
+
+// Allocations per operation, as reported by `go test -bench . -benchmem`:
+//
+//	| Benchmark | option | opt |
+//	|-----------|--------|-----|
+//	| Some      | 1      | 0   |
+//	| Map       | 1      | 0   |
+//	| AndThen   | 1      | 0   |
+//	| Filter    | 1      | 0   |
+
+// offset keeps the benchmarked values past the runtime's cache of small boxed integers.
+const offset = 1 << 20
+
+var (
+	sinkInt    int
+	sinkOpt    Option[int]
+	sinkOption option.Option[int]
+)
+
+func double(v int) int {
+	return v * 2
+}
+
+func someDouble(v int) Option[int] {
+	return Some(v * 2)
+}
+
+func optionDouble(v int) option.Option[int] {
+	return option.Some(v * 2)
+}
+
+func isEven(v int) bool {
+	return v%2 == 0
+}
+
+func pipeline(v int) int {
+	return AndThen(Map(Some(v), double), someDouble).Filter(isEven).UnwrapOr(0)
+}
+
+func TestOption_DoesNotAllocate(t *testing.T) {
+	v := fake.IntBetween(1, 1000)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		sinkInt = pipeline(v)
+	})
+
+	assert.Zero(t, allocs) //nolint:testifylint  // Using assert.Zero for consistency
+	assert.Equal(t, v*4, sinkInt)
+}
+
+func BenchmarkSome(b *testing.B) {
+	b.Run("option", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := range b.N {
+			sinkOption = option.Some(i + offset)
+		}
+	})
+
+	b.Run("opt", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := range b.N {
+			sinkOpt = Some(i + offset)
+		}
+	})
+}
+
+func BenchmarkMap(b *testing.B) {
+	b.Run("option", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := range b.N {
+			sinkOption = option.Map(option.Some(i+offset), double)
+		}
+	})
+
+	b.Run("opt", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := range b.N {
+			sinkOpt = Map(Some(i+offset), double)
+		}
+	})
+}
+
+func BenchmarkAndThen(b *testing.B) {
+	b.Run("option", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := range b.N {
+			sinkOption = option.AndThen(option.Some(i+offset), optionDouble)
+		}
+	})
+
+	b.Run("opt", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := range b.N {
+			sinkOpt = AndThen(Some(i+offset), someDouble)
+		}
+	})
+}
+
+func BenchmarkFilter(b *testing.B) {
+	b.Run("option", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := range b.N {
+			sinkOption = option.Some(i + offset).Filter(isEven)
+		}
+	})
+
+	b.Run("opt", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := range b.N {
+			sinkOpt = Some(i + offset).Filter(isEven)
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package opt
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON encodes Some as its value and None as null.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.ok {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(o.val)
+}
+
+// UnmarshalJSON decodes null as None and any other value as Some.
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = None[T]()
+
+		return nil
+	}
+
+	var val T
+	if err := json.Unmarshal(data, &val); err != nil {
+		return fmt.Errorf("failed to unmarshal option: %w", err)
+	}
+
+	*o = Some(val)
+
+	return nil
+}
+
+// Value converts Some to a driver.Value using the driver's default conversions and None to NULL.
+func (o Option[T]) Value() (driver.Value, error) {
+	if !o.ok {
+		return nil, nil //nolint:nilnil  // NULL is represented as a nil value
+	}
+
+	val, err := driver.DefaultParameterConverter.ConvertValue(o.val)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert option value: %w", err)
+	}
+
+	return val, nil
+}
+
+// Scan scans NULL as None and any other value as Some, with the same conversions as sql.Null.
+func (o *Option[T]) Scan(src any) error {
+	var v sql.Null[T]
+	if err := v.Scan(src); err != nil {
+		return fmt.Errorf("failed to scan option: %w", err)
+	}
+
+	if !v.Valid {
+		*o = None[T]()
+
+		return nil
+	}
+
+	*o = Some(v.V)
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package opt
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type payload struct {
+	Name     Option[string] `json:"name"`
+	Nickname Option[string] `json:"nickname,omitzero"`
+	Age      Option[int]    `json:"age"`
+}
+
+func TestOption_MarshalJSON(t *testing.T) {
+	value := fake.RandomStringWithLength(8)
+
+	data, err := json.Marshal(payload{
+		Name:     Some(value),
+		Nickname: None[string](),
+		Age:      None[int](),
+	})
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name": "`+value+`", "age": null}`, string(data))
+}
+
+func TestOption_UnmarshalJSON(t *testing.T) {
+	var p payload
+
+	err := json.Unmarshal([]byte(`{"name": "John", "age": null}`), &p)
+
+	require.NoError(t, err)
+	assert.Equal(t, payload{
+		Name:     Some("John"),
+		Nickname: None[string](),
+		Age:      None[int](),
+	}, p)
+
+	err = json.Unmarshal([]byte(`{"age": "old"}`), &p)
+
+	assert.ErrorContains(t, err, "failed to unmarshal option")
+}
+
+func TestOption_Value(t *testing.T) {
+	val, err := Some(int8(42)).Value()
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), val)
+
+	val, err = None[int8]().Value()
+
+	require.NoError(t, err)
+	assert.Nil(t, val)
+
+	_, err = Some(struct{}{}).Value()
+
+	assert.ErrorContains(t, err, "failed to convert option value")
+}
+
+func TestOption_Scan(t *testing.T) {
+	var o Option[int]
+
+	require.NoError(t, o.Scan("42"))
+	assert.Equal(t, Some(42), o)
+
+	require.NoError(t, o.Scan(nil))
+	assert.Equal(t, None[int](), o)
+
+	assert.ErrorContains(t, o.Scan("not a number"), "failed to scan option")
+}
@@ -0,0 +1,51 @@
+package opt
+
+// Match returns the result of `onSome` called with the value if the option is Some, or of `onNone` if it's None.
+func Match[T any, R any](o Option[T], onSome func(T) R, onNone func() R) R {
+	if !o.ok {
+		return onNone()
+	}
+
+	return onSome(o.val)
+}
+
+// SomeCase is the first step of a Switch, waiting for the Some handler.
+type SomeCase[T any] struct {
+	o Option[T]
+}
+
+// NoneCase is the last step of a Switch, waiting for the None handler.
+type NoneCase[T any] struct {
+	o      Option[T]
+	onSome func(T)
+}
+
+// Switch starts an exhaustive switch on the option, for side effects. Nothing runs until both handlers are given:
+//
+//	opt.Switch(o).
+//		Some(func(v T) { ... }).
+//		None(func() { ... })
+func Switch[T any](o Option[T]) SomeCase[T] {
+	return SomeCase[T]{
+		o: o,
+	}
+}
+
+// Some sets the handler called with the value if the option is Some.
+func (c SomeCase[T]) Some(f func(T)) NoneCase[T] {
+	return NoneCase[T]{
+		o:      c.o,
+		onSome: f,
+	}
+}
+
+// None sets the handler called if the option is None, and runs the matching handler.
+func (c NoneCase[T]) None(f func()) {
+	if c.o.ok {
+		c.onSome(c.o.val)
+
+		return
+	}
+
+	f()
+}
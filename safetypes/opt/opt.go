@@ -0,0 +1,339 @@
+// Package opt implements https://doc.rust-lang.org/std/option/enum.Option.html as a value type.
+//
+// It mirrors the option package, but Option is a struct instead of an interface, so creating and passing options
+// around doesn't allocate, options are comparable when T is, and the zero value is None.
+//
+// The APIs differ where the value type allows it:
+//   - Take, Replace, Insert, GetOrInsert and GetOrInsertWith are methods on *Option instead of functions taking one,
+//     and the last three return a pointer to the contained value, which can be modified in place, instead of a copy.
+//   - None takes the type parameter, like option.NoneOf.
+//   - Option encodes to JSON and SQL itself, so there's no Nullable. FromNonZero, FromNillable, Field and ApplyPatch
+//     are only in option; FromOption and ToOption convert between both.
+package opt
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+
+	"github.com/mathieu-lemay/go-sandbox/safetypes/option"
+	"github.com/mathieu-lemay/go-sandbox/safetypes/result"
+)
+
+// Option is a type that represents either a value (Some) or not (None).
+type Option[T any] struct {
+	val T
+	ok  bool
+}
+
+// Some creates a Some variant of Option from the value.
+func Some[T any](val T) Option[T] {
+	return Option[T]{
+		val: val,
+		ok:  true,
+	}
+}
+
+// None creates a None variant of Option.
+func None[T any]() Option[T] {
+	return Option[T]{} //nolint:exhaustruct  // The zero value is None
+}
+
+// FromPtr creates a Some from the value pointed to by ptr, or None if ptr is nil.
+func FromPtr[T any](ptr *T) Option[T] {
+	if ptr == nil {
+		return None[T]()
+	}
+
+	return Some(*ptr)
+}
+
+// FromOk creates a Some from the value if ok is `true`, or None otherwise.
+func FromOk[T any](val T, ok bool) Option[T] {
+	if !ok {
+		return None[T]()
+	}
+
+	return Some(val)
+}
+
+// FromOption converts an option.Option to an Option.
+func FromOption[T any](o option.Option[T]) Option[T] {
+	if o.IsNone() {
+		return None[T]()
+	}
+
+	return Some(o.Unwrap())
+}
+
+// ToOption converts the Option to an option.Option.
+func (o Option[T]) ToOption() option.Option[T] {
+	if !o.ok {
+		return option.NoneOf[T]()
+	}
+
+	return option.Some(o.val)
+}
+
+func (o Option[T]) IsNone() bool {
+	return !o.ok
+}
+
+func (o Option[T]) IsNoneOr(f func(T) bool) bool {
+	return !o.ok || f(o.val)
+}
+
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+func (o Option[T]) IsSomeAnd(f func(T) bool) bool {
+	return o.ok && f(o.val)
+}
+
+func (o Option[T]) Expect(msg string) T {
+	if !o.ok {
+		panic(errors.New(msg))
+	}
+
+	return o.val
+}
+
+func (o Option[T]) Unwrap() T {
+	if !o.ok {
+		panic(errors.New("called `Option.Unwrap()` on a `None` value"))
+	}
+
+	return o.val
+}
+
+func (o Option[T]) UnwrapOr(def T) T {
+	if !o.ok {
+		return def
+	}
+
+	return o.val
+}
+
+func (o Option[T]) UnwrapOrElse(f func() T) T {
+	if !o.ok {
+		return f()
+	}
+
+	return o.val
+}
+
+func (o Option[T]) UnwrapOrDefault() T {
+	return o.val
+}
+
+func (o Option[T]) Inspect(f func(T)) Option[T] {
+	if o.ok {
+		f(o.val)
+	}
+
+	return o
+}
+
+func (o Option[T]) Filter(f func(T) bool) Option[T] {
+	if o.ok && f(o.val) {
+		return o
+	}
+
+	return None[T]()
+}
+
+func (o Option[T]) Or(other Option[T]) Option[T] {
+	if o.ok {
+		return o
+	}
+
+	return other
+}
+
+func (o Option[T]) OrElse(f func() Option[T]) Option[T] {
+	if o.ok {
+		return o
+	}
+
+	return f()
+}
+
+func (o Option[T]) Xor(other Option[T]) Option[T] {
+	switch {
+	case o.ok && !other.ok:
+		return o
+	case !o.ok && other.ok:
+		return other
+	default:
+		return None[T]()
+	}
+}
+
+// OkOr transforms Some(v) into Ok(v) and None into Err(err).
+func (o Option[T]) OkOr(err error) result.Result[T, error] {
+	if !o.ok {
+		return result.Err[T](err)
+	}
+
+	return result.Ok[T, error](o.val)
+}
+
+// OkOrElse transforms Some(v) into Ok(v) and None into Err(f()).
+func (o Option[T]) OkOrElse(f func() error) result.Result[T, error] {
+	if !o.ok {
+		return result.Err[T](f())
+	}
+
+	return result.Ok[T, error](o.val)
+}
+
+// Take returns the option and leaves None in its place.
+func (o *Option[T]) Take() Option[T] {
+	old := *o
+	*o = None[T]()
+
+	return old
+}
+
+// Replace puts Some(val) in place of the option and returns the old option.
+func (o *Option[T]) Replace(val T) Option[T] {
+	old := *o
+	*o = Some(val)
+
+	return old
+}
+
+// Insert puts Some(val) in place of the option, discarding the old option, and returns a pointer to the value, which
+// can be used to modify it in place.
+func (o *Option[T]) Insert(val T) *T {
+	*o = Some(val)
+
+	return &o.val
+}
+
+// GetOrInsert puts Some(val) in place of the option if it's None, then returns a pointer to the contained value.
+func (o *Option[T]) GetOrInsert(val T) *T {
+	if !o.ok {
+		return o.Insert(val)
+	}
+
+	return &o.val
+}
+
+// GetOrInsertWith puts Some with the result of `f` in place of the option if it's None, then returns a pointer to the
+// contained value.
+func (o *Option[T]) GetOrInsertWith(f func() T) *T {
+	if !o.ok {
+		return o.Insert(f())
+	}
+
+	return &o.val
+}
+
+// All returns an iterator over the value, yielding it once if Some and nothing if None.
+func (o Option[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if o.ok {
+			yield(o.val)
+		}
+	}
+}
+
+// ToPtr returns a pointer to a copy of the value, or nil if None.
+func (o Option[T]) ToPtr() *T {
+	if !o.ok {
+		return nil
+	}
+
+	val := o.val
+
+	return &val
+}
+
+func (o Option[T]) String() string {
+	if !o.ok {
+		return "None"
+	}
+
+	return fmt.Sprintf("Some(%v)", o.val)
+}
+
+// Map maps an Option<T> to Option<U> by applying a function to a contained value (if Some) or returns None (if None).
+func Map[T any, U any](o Option[T], f func(T) U) Option[U] {
+	if !o.ok {
+		return None[U]()
+	}
+
+	return Some(f(o.val))
+}
+
+// MapOr returns the provided default result (if None), or applies a function to the contained value (if Some).
+func MapOr[T any, U any](o Option[T], def U, f func(T) U) U {
+	if !o.ok {
+		return def
+	}
+
+	return f(o.val)
+}
+
+// MapOrElse computes a default function result (if None), or applies a different function to the contained value (if
+// Some).
+func MapOrElse[T any, U any](o Option[T], factory func() U, f func(T) U) U {
+	if !o.ok {
+		return factory()
+	}
+
+	return f(o.val)
+}
+
+// And returns None if the option is None, otherwise returns `optb`.
+func And[T any, U any](o Option[T], other Option[U]) Option[U] {
+	if !o.ok {
+		return None[U]()
+	}
+
+	return other
+}
+
+// AndThen returns None if the option is None, otherwise calls `f` with the wrapped value and returns the result.
+func AndThen[T any, U any](o Option[T], f func(T) Option[U]) Option[U] {
+	if !o.ok {
+		return None[U]()
+	}
+
+	return f(o.val)
+}
+
+// Zip returns Some with a Pair of both values if both options are Some, otherwise returns None.
+func Zip[T any, U any](o Option[T], other Option[U]) Option[option.Pair[T, U]] {
+	return ZipWith(o, other, func(a T, b U) option.Pair[T, U] {
+		return option.Pair[T, U]{
+			First:  a,
+			Second: b,
+		}
+	})
+}
+
+// ZipWith returns Some with the result of `f` called with both values if both options are Some, otherwise returns None.
+func ZipWith[T any, U any, R any](o Option[T], other Option[U], f func(T, U) R) Option[R] {
+	if !o.ok || !other.ok {
+		return None[R]()
+	}
+
+	return Some(f(o.val, other.val))
+}
+
+// Unzip returns both values of the Pair as Some if the option is Some, otherwise returns two None.
+func Unzip[T any, U any](o Option[option.Pair[T, U]]) (Option[T], Option[U]) {
+	if !o.ok {
+		return None[T](), None[U]()
+	}
+
+	return Some(o.val.First), Some(o.val.Second)
+}
+
+// Flatten removes one level of nesting from an Option<Option<T>>.
+func Flatten[T any](o Option[Option[T]]) Option[T] {
+	return o.val
+}
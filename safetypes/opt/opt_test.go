@@ -0,0 +1,347 @@
+package opt
+
+import (
+	"errors"
+	"math/rand"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mathieu-lemay/go-sandbox/safetypes/option"
+	"github.com/mathieu-lemay/go-sandbox/safetypes/result"
+)
+
+var fake = faker.NewWithSeed(rand.NewSource(time.Now().UnixNano()))
+
+func TestSome_ReturnsNewSomeOption(t *testing.T) {
+	v := fake.Lorem().Word()
+
+	assert.Equal(t, Option[string]{val: v, ok: true}, Some(v))
+}
+
+func TestNone_IsTheZeroValue(t *testing.T) {
+	var zero Option[int]
+
+	assert.Equal(t, zero, None[int]())
+}
+
+func TestOption_IsComparable(t *testing.T) {
+	v := fake.Int()
+
+	assert.True(t, Some(v) == Some(v))
+	assert.False(t, Some(v) == Some(v+1))
+	assert.False(t, Some(0) == None[int]())
+	assert.True(t, None[int]() == None[int]())
+}
+
+func TestOption_ConvertsToAndFromOption(t *testing.T) {
+	v := fake.Int()
+
+	assert.Equal(t, Some(v), FromOption(option.Some(v)))
+	assert.Equal(t, None[int](), FromOption(option.NoneOf[int]()))
+
+	assert.Equal(t, option.Some(v), Some(v).ToOption())
+	assert.True(t, None[int]().ToOption().IsNone())
+}
+
+func TestOption_ConvertsToAndFromPointers(t *testing.T) {
+	zero := 0
+
+	assert.Equal(t, Some(0), FromPtr(&zero))
+	assert.Equal(t, None[int](), FromPtr[int](nil))
+
+	ptr := Some(0).ToPtr()
+	if assert.NotNil(t, ptr) {
+		assert.Equal(t, 0, *ptr)
+	}
+
+	assert.Nil(t, None[int]().ToPtr())
+}
+
+func TestFromOk_ReturnsSomeIfOk(t *testing.T) {
+	m := map[string]int{"zero": 0}
+
+	v, ok := m["zero"]
+	assert.Equal(t, Some(0), FromOk(v, ok))
+
+	v, ok = m["missing"]
+	assert.Equal(t, None[int](), FromOk(v, ok))
+
+	assert.Equal(t, None[int](), FromOk(fake.IntBetween(1, 100), false), "None should be the zero value")
+}
+
+func TestOption_Predicates(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	assert.False(t, Some(1).IsNone())
+	assert.True(t, None[int]().IsNone())
+	assert.True(t, Some(1).IsSome())
+	assert.False(t, None[int]().IsSome())
+
+	assert.True(t, Some(2).IsNoneOr(isEven))
+	assert.False(t, Some(1).IsNoneOr(isEven))
+	assert.True(t, None[int]().IsNoneOr(isEven))
+
+	assert.True(t, Some(2).IsSomeAnd(isEven))
+	assert.False(t, Some(1).IsSomeAnd(isEven))
+	assert.False(t, None[int]().IsSomeAnd(isEven))
+}
+
+func TestOption_Unwrap(t *testing.T) {
+	v := fake.Int()
+	def := fake.Int()
+	factory := func() int { return def }
+
+	t.Run("some", func(t *testing.T) {
+		o := Some(v)
+
+		assert.Equal(t, v, o.Expect("should not panic"))
+		assert.Equal(t, v, o.Unwrap())
+		assert.Equal(t, v, o.UnwrapOr(def))
+		assert.Equal(t, v, o.UnwrapOrElse(factory))
+		assert.Equal(t, v, o.UnwrapOrDefault())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		o := None[int]()
+		msg := fake.Lorem().Sentence(4)
+
+		assert.PanicsWithError(t, msg, func() { o.Expect(msg) })
+		assert.PanicsWithError(t, "called `Option.Unwrap()` on a `None` value", func() { o.Unwrap() })
+		assert.Equal(t, def, o.UnwrapOr(def))
+		assert.Equal(t, def, o.UnwrapOrElse(factory))
+		assert.Zero(t, o.UnwrapOrDefault())
+	})
+}
+
+func TestOption_Inspect(t *testing.T) {
+	v := fake.Int()
+
+	var seen []int
+
+	inspect := func(v int) { seen = append(seen, v) }
+
+	assert.Equal(t, Some(v), Some(v).Inspect(inspect))
+	assert.Equal(t, None[int](), None[int]().Inspect(inspect))
+	assert.Equal(t, []int{v}, seen)
+}
+
+func TestOption_Filter(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	assert.Equal(t, Some(2), Some(2).Filter(isEven))
+	assert.Equal(t, None[int](), Some(1).Filter(isEven))
+	assert.Equal(t, None[int](), None[int]().Filter(isEven))
+}
+
+func TestOption_Or(t *testing.T) {
+	a := Some(fake.Int())
+	b := Some(fake.Int())
+	n := None[int]()
+
+	assert.Equal(t, a, a.Or(b))
+	assert.Equal(t, a, a.Or(n))
+	assert.Equal(t, b, n.Or(b))
+	assert.Equal(t, n, n.Or(n))
+
+	assert.Equal(t, a, a.OrElse(func() Option[int] { return b }))
+	assert.Equal(t, b, n.OrElse(func() Option[int] { return b }))
+}
+
+func TestOption_Xor(t *testing.T) {
+	a := Some(fake.Int())
+	b := Some(fake.Int())
+	n := None[int]()
+
+	assert.Equal(t, n, a.Xor(b))
+	assert.Equal(t, a, a.Xor(n))
+	assert.Equal(t, b, n.Xor(b))
+	assert.Equal(t, n, n.Xor(n))
+}
+
+func TestOption_String(t *testing.T) {
+	v := fake.Int()
+
+	assert.Equal(t, "Some("+strconv.Itoa(v)+")", Some(v).String())
+	assert.Equal(t, "None", None[int]().String())
+}
+
+func TestMap_ReturnsANewOptionWithMappedValue(t *testing.T) {
+	v := fake.Int()
+
+	assert.Equal(t, Some(strconv.Itoa(v)), Map(Some(v), strconv.Itoa))
+	assert.Equal(t, None[string](), Map(None[int](), strconv.Itoa))
+}
+
+func TestMapOr_ReturnsTheMappedValueOrDefault(t *testing.T) {
+	v := fake.Int()
+
+	assert.Equal(t, strconv.Itoa(v), MapOr(Some(v), "default", strconv.Itoa))
+	assert.Equal(t, "default", MapOr(None[int](), "default", strconv.Itoa))
+}
+
+func TestMapOrElse_ReturnsTheMappedValueOrCallsDefaultFactory(t *testing.T) {
+	v := fake.Int()
+	factory := func() string { return "default" }
+
+	assert.Equal(t, strconv.Itoa(v), MapOrElse(Some(v), factory, strconv.Itoa))
+	assert.Equal(t, "default", MapOrElse(None[int](), factory, strconv.Itoa))
+}
+
+func TestAnd_ReturnsOtherOrNone(t *testing.T) {
+	other := Some(fake.Lorem().Word())
+
+	assert.Equal(t, other, And(Some(fake.Int()), other))
+	assert.Equal(t, None[string](), And(None[int](), other))
+}
+
+func TestAndThen_ReturnsMappedOrNone(t *testing.T) {
+	parse := func(s string) Option[int] {
+		v, err := strconv.Atoi(s)
+		if errors.Is(err, strconv.ErrSyntax) {
+			return None[int]()
+		}
+
+		return Some(v)
+	}
+
+	assert.Equal(t, Some(42), AndThen(Some("42"), parse))
+	assert.Equal(t, None[int](), AndThen(Some("nope"), parse))
+	assert.Equal(t, None[int](), AndThen(None[string](), parse))
+}
+
+func TestOption_OkOr(t *testing.T) {
+	value := fake.Int()
+	err := errors.New(fake.RandomStringWithLength(8))
+
+	assert.Equal(t, result.Ok[int, error](value), Some(value).OkOr(err))
+	assert.Equal(t, result.Err[int](err), None[int]().OkOr(err))
+	assert.Equal(t, result.Ok[int, error](value), Some(value).OkOrElse(func() error { return err }))
+	assert.Equal(t, result.Err[int](err), None[int]().OkOrElse(func() error { return err }))
+}
+
+func TestOption_TakeAndReplace(t *testing.T) {
+	old := fake.Int()
+	value := fake.Int()
+
+	o := Some(old)
+
+	assert.Equal(t, Some(old), o.Take())
+	assert.Equal(t, None[int](), o)
+
+	assert.Equal(t, None[int](), o.Replace(value))
+	assert.Equal(t, Some(value), o)
+
+	assert.Equal(t, Some(value), o.Replace(old))
+	assert.Equal(t, Some(old), o)
+}
+
+func TestOption_InsertReturnsAPointerToTheValue(t *testing.T) {
+	value := fake.Int()
+
+	var o Option[int]
+
+	ptr := o.Insert(value)
+	*ptr++
+
+	assert.Equal(t, Some(value+1), o)
+}
+
+func TestOption_GetOrInsertOnlyInsertsIfNone(t *testing.T) {
+	old := fake.Int()
+	value := fake.Int()
+
+	o := None[int]()
+
+	assert.Equal(t, value, *o.GetOrInsert(value))
+	assert.Equal(t, value, *o.GetOrInsert(old))
+	assert.Equal(t, value, *o.GetOrInsertWith(func() int {
+		assert.Fail(t, "should not be called")
+
+		return old
+	}))
+	assert.Equal(t, Some(value), o)
+
+	o = None[int]()
+
+	assert.Equal(t, old, *o.GetOrInsertWith(func() int { return old }))
+	assert.Equal(t, Some(old), o)
+}
+
+func TestZip_ReturnsAPairIfBothAreSome(t *testing.T) {
+	a := fake.Int()
+	b := fake.RandomStringWithLength(8)
+
+	assert.Equal(t, Some(option.Pair[int, string]{First: a, Second: b}), Zip(Some(a), Some(b)))
+	assert.Equal(t, None[option.Pair[int, string]](), Zip(Some(a), None[string]()))
+	assert.Equal(t, None[option.Pair[int, string]](), Zip(None[int](), Some(b)))
+
+	first, second := Unzip(Zip(Some(a), Some(b)))
+	assert.Equal(t, Some(a), first)
+	assert.Equal(t, Some(b), second)
+
+	first, second = Unzip(None[option.Pair[int, string]]())
+	assert.Equal(t, None[int](), first)
+	assert.Equal(t, None[string](), second)
+}
+
+func TestZipWith_CombinesTheValuesIfBothAreSome(t *testing.T) {
+	combine := func(a int, b string) string { return strconv.Itoa(a) + b }
+
+	assert.Equal(t, Some("1a"), ZipWith(Some(1), Some("a"), combine))
+	assert.Equal(t, None[string](), ZipWith(None[int](), Some("a"), combine))
+}
+
+func TestFlatten_RemovesOneLevelOfNesting(t *testing.T) {
+	value := fake.Int()
+
+	assert.Equal(t, Some(value), Flatten(Some(Some(value))))
+	assert.Equal(t, None[int](), Flatten(Some(None[int]())))
+	assert.Equal(t, None[int](), Flatten(None[Option[int]]()))
+}
+
+func TestMatch_CallsTheMatchingFunction(t *testing.T) {
+	value := fake.Int()
+	onNone := func() string { return "none" }
+
+	assert.Equal(t, strconv.Itoa(value), Match(Some(value), strconv.Itoa, onNone))
+	assert.Equal(t, "none", Match(None[int](), strconv.Itoa, onNone))
+}
+
+func TestSwitch_RunsTheMatchingHandler(t *testing.T) {
+	value := fake.Int()
+
+	var got int
+
+	Switch(Some(value)).
+		Some(func(v int) { got = v }).
+		None(func() { assert.Fail(t, "should not be called") })
+
+	assert.Equal(t, value, got)
+
+	called := false
+
+	Switch(None[int]()).
+		Some(func(int) { assert.Fail(t, "should not be called") }).
+		None(func() { called = true })
+
+	assert.True(t, called)
+}
+
+func TestOption_All(t *testing.T) {
+	value := fake.Int()
+
+	var values []int
+	for v := range Some(value).All() {
+		values = append(values, v)
+	}
+
+	assert.Equal(t, []int{value}, values)
+
+	for range None[int]().All() {
+		assert.Fail(t, "should not yield any value")
+	}
+}
@@ -0,0 +1,208 @@
+package option
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrInvalidPatch is returned by ApplyPatch when the patch can't be applied onto the destination.
+var ErrInvalidPatch = errors.New("invalid patch")
+
+// Field is a struct field that records whether its JSON key was absent, explicitly null, or set to a value, which is
+// what PATCH endpoints need to tell "leave unchanged" from "clear". The zero value is absent, so a Field tagged with
+// `omitzero` is omitted from the output when absent.
+type Field[T any] struct {
+	present bool
+	null    bool
+	val     T
+}
+
+// AbsentField creates a Field whose key was absent.
+func AbsentField[T any]() Field[T] {
+	return Field[T]{} //nolint:exhaustruct  // The zero value is absent
+}
+
+// NullField creates a Field whose key was explicitly null.
+func NullField[T any]() Field[T] {
+	var zero T
+
+	return Field[T]{
+		present: true,
+		null:    true,
+		val:     zero,
+	}
+}
+
+// FieldOf creates a Field set to the given value.
+func FieldOf[T any](val T) Field[T] {
+	return Field[T]{
+		present: true,
+		null:    false,
+		val:     val,
+	}
+}
+
+// IsAbsent returns `true` if the key was absent.
+func (f Field[T]) IsAbsent() bool {
+	return !f.present
+}
+
+// IsNull returns `true` if the key was explicitly null.
+func (f Field[T]) IsNull() bool {
+	return f.present && f.null
+}
+
+// IsSet returns `true` if the key was set to a value.
+func (f Field[T]) IsSet() bool {
+	return f.present && !f.null
+}
+
+// IsZero returns `true` if the key was absent.
+func (f Field[T]) IsZero() bool {
+	return f.IsAbsent()
+}
+
+// Option returns the value as Some, or None if the key was absent or null.
+func (f Field[T]) Option() Option[T] {
+	if !f.IsSet() {
+		return none[T]{}
+	}
+
+	return Some(f.val)
+}
+
+// ApplyTo sets dst to the value if set, or to the zero value if null. dst is left unchanged if the key was absent.
+func (f Field[T]) ApplyTo(dst *T) {
+	if f.present {
+		*dst = f.val
+	}
+}
+
+// ApplyToPtr sets dst to a pointer to the value if set, or to nil if null. dst is left unchanged if the key was absent.
+func (f Field[T]) ApplyToPtr(dst **T) {
+	switch {
+	case f.IsAbsent():
+	case f.IsNull():
+		*dst = nil
+	default:
+		val := f.val
+		*dst = &val
+	}
+}
+
+// ApplyToOption sets dst to Some if set, or to None if null. dst is left unchanged if the key was absent.
+func (f Field[T]) ApplyToOption(dst *Option[T]) {
+	if f.present {
+		*dst = f.Option()
+	}
+}
+
+// MarshalJSON encodes the value, or null if the key was absent or null.
+func (f Field[T]) MarshalJSON() ([]byte, error) {
+	return f.Option().MarshalJSON()
+}
+
+// UnmarshalJSON is only called when the key is present, so it records null or the value.
+func (f *Field[T]) UnmarshalJSON(data []byte) error {
+	var n Nullable[T]
+	if err := n.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	f.present = true
+	f.null = n.Option().IsNone()
+	f.val = n.Option().UnwrapOrDefault()
+
+	return nil
+}
+
+func (f Field[T]) String() string {
+	switch {
+	case f.IsAbsent():
+		return "Absent"
+	case f.IsNull():
+		return "Null"
+	default:
+		return fmt.Sprintf("Field(%v)", f.val)
+	}
+}
+
+// applyTo applies the field onto a struct field of type T, *T, Option[T], Nullable[T] or Field[T].
+func (f Field[T]) applyTo(dst reflect.Value) error {
+	switch d := dst.Addr().Interface().(type) {
+	case *T:
+		f.ApplyTo(d)
+	case **T:
+		f.ApplyToPtr(d)
+	case *Option[T]:
+		f.ApplyToOption(d)
+	case *Nullable[T]:
+		if f.present {
+			*d = NewNullable(f.Option())
+		}
+	case *Field[T]:
+		if f.present {
+			*d = f
+		}
+	default:
+		return fmt.Errorf("%w: can't apply %T onto %s", ErrInvalidPatch, f, dst.Type())
+	}
+
+	return nil
+}
+
+type patchField interface {
+	applyTo(dst reflect.Value) error
+}
+
+// ApplyPatch applies every Field of the patch struct onto the field with the same name in the struct pointed to by
+// dst, leaving the fields whose key was absent unchanged. Fields of the patch that aren't a Field are ignored.
+func ApplyPatch(dst any, patch any) error {
+	dv := reflect.ValueOf(dst)
+	if dv.Kind() != reflect.Pointer || dv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%w: destination must be a pointer to a struct, got %T", ErrInvalidPatch, dst)
+	}
+
+	dv = dv.Elem()
+
+	pv := reflect.Indirect(reflect.ValueOf(patch))
+	if pv.Kind() != reflect.Struct {
+		return fmt.Errorf("%w: patch must be a struct, got %T", ErrInvalidPatch, patch)
+	}
+
+	pt := pv.Type()
+
+	for i := range pt.NumField() {
+		sf := pt.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		field, ok := pv.Field(i).Interface().(patchField)
+		if !ok {
+			continue
+		}
+
+		dsf, ok := dv.Type().FieldByName(sf.Name)
+		if !ok {
+			return fmt.Errorf("%w: destination has no settable field %s", ErrInvalidPatch, sf.Name)
+		}
+
+		// FieldByName would panic if the field is promoted through a nil embedded pointer
+		df, err := dv.FieldByIndexErr(dsf.Index)
+		if err != nil {
+			return fmt.Errorf("%w: destination field %s: %w", ErrInvalidPatch, sf.Name, err)
+		}
+
+		if !df.CanSet() {
+			return fmt.Errorf("%w: destination has no settable field %s", ErrInvalidPatch, sf.Name)
+		}
+
+		if err := field.applyTo(df); err != nil {
+			return fmt.Errorf("field %s: %w", sf.Name, err)
+		}
+	}
+
+	return nil
+}
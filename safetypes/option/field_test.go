@@ -0,0 +1,233 @@
+package option
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type user struct {
+	Name     string
+	Nickname *string
+	Age      int
+	Email    Option[string]
+}
+
+type userPatch struct {
+	Name     Field[string] `json:"name"`
+	Nickname Field[string] `json:"nickname,omitzero"`
+	Age      Field[int]    `json:"age,omitzero"`
+	Email    Field[string] `json:"email,omitzero"`
+}
+
+func TestField_States(t *testing.T) {
+	value := fake.RandomStringWithLength(8)
+
+	tests := []struct {
+		name   string
+		field  Field[string]
+		absent bool
+		null   bool
+		set    bool
+		opt    Option[string]
+		str    string
+	}{
+		{"absent", AbsentField[string](), true, false, false, none[string]{}, "Absent"},
+		{"null", NullField[string](), false, true, false, none[string]{}, "Null"},
+		{"set", FieldOf(value), false, false, true, Some(value), "Field(" + value + ")"},
+		{"set to zero", FieldOf(""), false, false, true, Some(""), "Field()"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.absent, tt.field.IsAbsent())
+			assert.Equal(t, tt.absent, tt.field.IsZero())
+			assert.Equal(t, tt.null, tt.field.IsNull())
+			assert.Equal(t, tt.set, tt.field.IsSet())
+			assert.Equal(t, tt.opt, tt.field.Option())
+			assert.Equal(t, tt.str, tt.field.String())
+		})
+	}
+}
+
+func TestField_UnmarshalJSON(t *testing.T) {
+	var p userPatch
+
+	err := json.Unmarshal([]byte(`{"name": "John", "nickname": null, "age": 0}`), &p)
+
+	require.NoError(t, err)
+	assert.Equal(t, FieldOf("John"), p.Name)
+	assert.Equal(t, NullField[string](), p.Nickname)
+	assert.Equal(t, FieldOf(0), p.Age)
+	assert.Equal(t, AbsentField[string](), p.Email)
+}
+
+func TestField_UnmarshalJSONReturnsErrors(t *testing.T) {
+	var p userPatch
+
+	err := json.Unmarshal([]byte(`{"age": "old"}`), &p)
+
+	assert.ErrorContains(t, err, "failed to unmarshal option")
+}
+
+func TestField_MarshalJSON(t *testing.T) {
+	p := userPatch{
+		Name:     NullField[string](),
+		Nickname: AbsentField[string](),
+		Age:      FieldOf(0),
+		Email:    FieldOf("john@example.com"),
+	}
+
+	data, err := json.Marshal(p)
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name": null, "age": 0, "email": "john@example.com"}`, string(data))
+}
+
+func TestField_ApplyTo(t *testing.T) {
+	value := fake.Int()
+
+	for _, tc := range []struct {
+		name     string
+		field    Field[int]
+		expected int
+	}{
+		{"absent", AbsentField[int](), value},
+		{"null", NullField[int](), 0},
+		{"set", FieldOf(42), 42},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dst := value
+
+			tc.field.ApplyTo(&dst)
+
+			assert.Equal(t, tc.expected, dst)
+		})
+	}
+}
+
+func TestField_ApplyToPtr(t *testing.T) {
+	value := fake.RandomStringWithLength(8)
+
+	t.Run("absent", func(t *testing.T) {
+		dst := &value
+
+		AbsentField[string]().ApplyToPtr(&dst)
+
+		assert.Same(t, &value, dst)
+	})
+
+	t.Run("null", func(t *testing.T) {
+		dst := &value
+
+		NullField[string]().ApplyToPtr(&dst)
+
+		assert.Nil(t, dst)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		var dst *string
+
+		FieldOf(value).ApplyToPtr(&dst)
+
+		require.NotNil(t, dst)
+		assert.Equal(t, value, *dst)
+	})
+}
+
+func TestField_ApplyToOption(t *testing.T) {
+	value := fake.RandomStringWithLength(8)
+
+	for _, tc := range []struct {
+		name     string
+		field    Field[string]
+		expected Option[string]
+	}{
+		{"absent", AbsentField[string](), Some("original")},
+		{"null", NullField[string](), none[string]{}},
+		{"set", FieldOf(value), Some(value)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dst := Some("original")
+
+			tc.field.ApplyToOption(&dst)
+
+			assert.Equal(t, tc.expected, dst)
+		})
+	}
+}
+
+func TestApplyPatch_AppliesFieldsOntoTheStruct(t *testing.T) {
+	nickname := "Johnny"
+	u := user{
+		Name:     "John",
+		Nickname: &nickname,
+		Age:      42,
+		Email:    Some("john@example.com"),
+	}
+
+	var p userPatch
+
+	require.NoError(t, json.Unmarshal([]byte(`{"name": "Jack", "nickname": null, "email": null}`), &p))
+
+	err := ApplyPatch(&u, p)
+
+	require.NoError(t, err)
+	assert.Equal(t, user{
+		Name:     "Jack",
+		Nickname: nil,
+		Age:      42,
+		Email:    none[string]{},
+	}, u)
+}
+
+func TestApplyPatch_ReturnsAnErrorIfThePatchIsInvalid(t *testing.T) {
+	var u user
+
+	t.Run("destination is not a pointer", func(t *testing.T) {
+		err := ApplyPatch(u, userPatch{}) //nolint:exhaustruct  // We want the zero value
+
+		require.ErrorIs(t, err, ErrInvalidPatch)
+		assert.ErrorContains(t, err, "destination must be a pointer to a struct")
+	})
+
+	t.Run("patch is not a struct", func(t *testing.T) {
+		err := ApplyPatch(&u, 42)
+
+		require.ErrorIs(t, err, ErrInvalidPatch)
+		assert.ErrorContains(t, err, "patch must be a struct")
+	})
+
+	t.Run("field is missing", func(t *testing.T) {
+		err := ApplyPatch(&u, struct{ Missing Field[int] }{FieldOf(1)})
+
+		require.ErrorIs(t, err, ErrInvalidPatch)
+		assert.ErrorContains(t, err, "destination has no settable field Missing")
+	})
+
+	t.Run("field has the wrong type", func(t *testing.T) {
+		err := ApplyPatch(&u, struct{ Age Field[string] }{FieldOf("old")})
+
+		require.ErrorIs(t, err, ErrInvalidPatch)
+		assert.ErrorContains(t, err, "field Age")
+	})
+
+	t.Run("field is in a nil embedded struct", func(t *testing.T) {
+		type Base struct {
+			Name string
+		}
+
+		var dst struct {
+			*Base
+
+			Age int
+		}
+
+		err := ApplyPatch(&dst, struct{ Name Field[string] }{FieldOf("Jack")})
+
+		require.ErrorIs(t, err, ErrInvalidPatch)
+		assert.ErrorContains(t, err, "destination field Name")
+	})
+}
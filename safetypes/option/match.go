@@ -0,0 +1,52 @@
+package option
+
+// Match returns the result of `onSome` called with the value if the option is Some, or of `onNone` if it's None.
+func Match[T any, R any](opt Option[T], onSome func(T) R, onNone func() R) R {
+	s, ok := opt.(some[T])
+	if !ok {
+		return onNone()
+	}
+
+	return onSome(s.val)
+}
+
+// SomeCase is the first step of a Switch, waiting for the Some handler.
+type SomeCase[T any] struct {
+	opt Option[T]
+}
+
+// NoneCase is the last step of a Switch, waiting for the None handler.
+type NoneCase[T any] struct {
+	opt    Option[T]
+	onSome func(T)
+}
+
+// Switch starts an exhaustive switch on the option, for side effects. Nothing runs until both handlers are given:
+//
+//	option.Switch(opt).
+//		Some(func(v T) { ... }).
+//		None(func() { ... })
+func Switch[T any](opt Option[T]) SomeCase[T] {
+	return SomeCase[T]{
+		opt: opt,
+	}
+}
+
+// Some sets the handler called with the value if the option is Some.
+func (c SomeCase[T]) Some(f func(T)) NoneCase[T] {
+	return NoneCase[T]{
+		opt:    c.opt,
+		onSome: f,
+	}
+}
+
+// None sets the handler called if the option is None, and runs the matching handler.
+func (c NoneCase[T]) None(f func()) {
+	if s, ok := c.opt.(some[T]); ok {
+		c.onSome(s.val)
+
+		return
+	}
+
+	f()
+}
@@ -0,0 +1,44 @@
+package option
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch_CallsTheMatchingFunction(t *testing.T) {
+	value := fake.Int()
+	onNone := func() string { return "none" }
+
+	assert.Equal(t, strconv.Itoa(value), Match(Some(value), strconv.Itoa, onNone))
+	assert.Equal(t, "none", Match(NoneOf[int](), strconv.Itoa, onNone))
+}
+
+func TestSwitch_RunsTheMatchingHandler(t *testing.T) {
+	value := fake.Int()
+
+	t.Run("some", func(t *testing.T) {
+		var got int
+
+		Switch(Some(value)).
+			Some(func(v int) { got = v }).
+			None(func() { assert.Fail(t, "should not be called") })
+
+		assert.Equal(t, value, got)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		called := false
+
+		Switch(NoneOf[int]()).
+			Some(func(int) { assert.Fail(t, "should not be called") }).
+			None(func() { called = true })
+
+		assert.True(t, called)
+	})
+
+	t.Run("incomplete", func(t *testing.T) {
+		Switch(Some(value)).Some(func(int) { assert.Fail(t, "should not be called") })
+	})
+}
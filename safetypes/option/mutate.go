@@ -0,0 +1,43 @@
+package option
+
+// Take returns the option and leaves None in its place.
+func Take[T any](opt *Option[T]) Option[T] {
+	old := *opt
+	*opt = none[T]{}
+
+	if old == nil {
+		return none[T]{}
+	}
+
+	return old
+}
+
+// Replace puts Some(val) in place of the option and returns the old option.
+func Replace[T any](opt *Option[T], val T) Option[T] {
+	old := Take(opt)
+	*opt = Some(val)
+
+	return old
+}
+
+// Insert puts Some(val) in place of the option, discarding the old option, and returns the value.
+func Insert[T any](opt *Option[T], val T) T {
+	*opt = Some(val)
+
+	return val
+}
+
+// GetOrInsert puts Some(val) in place of the option if it's None, then returns the contained value.
+func GetOrInsert[T any](opt *Option[T], val T) T {
+	return GetOrInsertWith(opt, func() T { return val })
+}
+
+// GetOrInsertWith puts Some with the result of `f` in place of the option if it's None, then returns the contained
+// value.
+func GetOrInsertWith[T any](opt *Option[T], f func() T) T {
+	if s, ok := (*opt).(some[T]); ok {
+		return s.val
+	}
+
+	return Insert(opt, f())
+}
@@ -0,0 +1,93 @@
+package option
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTake_LeavesNoneInPlace(t *testing.T) {
+	value := fake.Int()
+
+	t.Run("some", func(t *testing.T) {
+		opt := Some(value)
+
+		assert.Equal(t, Some(value), Take(&opt))
+		assert.Equal(t, NoneOf[int](), opt)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		opt := NoneOf[int]()
+
+		assert.Equal(t, NoneOf[int](), Take(&opt))
+		assert.Equal(t, NoneOf[int](), opt)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		var opt Option[int]
+
+		assert.Equal(t, NoneOf[int](), Take(&opt))
+		assert.Equal(t, NoneOf[int](), opt)
+	})
+}
+
+func TestReplace_ReturnsTheOldOption(t *testing.T) {
+	old := fake.Int()
+	value := fake.Int()
+
+	t.Run("some", func(t *testing.T) {
+		opt := Some(old)
+
+		assert.Equal(t, Some(old), Replace(&opt, value))
+		assert.Equal(t, Some(value), opt)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		opt := NoneOf[int]()
+
+		assert.Equal(t, NoneOf[int](), Replace(&opt, value))
+		assert.Equal(t, Some(value), opt)
+	})
+}
+
+func TestInsert_ReplacesTheOption(t *testing.T) {
+	value := fake.Int()
+
+	for _, opt := range []Option[int]{Some(fake.Int()), NoneOf[int]()} {
+		assert.Equal(t, value, Insert(&opt, value))
+		assert.Equal(t, Some(value), opt)
+	}
+}
+
+func TestGetOrInsertWith_OnlyInsertsIfNone(t *testing.T) {
+	old := fake.Int()
+	value := fake.Int()
+
+	t.Run("some", func(t *testing.T) {
+		opt := Some(old)
+
+		res := GetOrInsertWith(&opt, func() int {
+			assert.Fail(t, "should not be called")
+
+			return value
+		})
+
+		assert.Equal(t, old, res)
+		assert.Equal(t, Some(old), opt)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		opt := NoneOf[int]()
+
+		assert.Equal(t, value, GetOrInsertWith(&opt, func() int { return value }))
+		assert.Equal(t, Some(value), opt)
+	})
+
+	t.Run("get or insert", func(t *testing.T) {
+		opt := NoneOf[int]()
+
+		assert.Equal(t, value, GetOrInsert(&opt, value))
+		assert.Equal(t, value, GetOrInsert(&opt, old), "the value should not be replaced")
+		assert.Equal(t, Some(value), opt)
+	})
+}
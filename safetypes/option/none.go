@@ -2,15 +2,23 @@ package option
 
 import (
 	"errors"
+	"iter"
+
+	"github.com/mathieu-lemay/go-sandbox/safetypes/result"
 )
 
 type none[T any] struct{}
 
-// None creates a None variant of Option.
+// None creates a None variant of Option. Its type is Option[any], use NoneOf for a None of a specific type.
 func None() Option[any] {
 	return none[any]{}
 }
 
+// NoneOf creates a None variant of Option[T].
+func NoneOf[T any]() Option[T] {
+	return none[T]{}
+}
+
 func (n none[T]) IsNone() bool {
 	return true
 }
@@ -69,6 +77,26 @@ func (n none[T]) Xor(other Option[T]) Option[T] {
 	return other
 }
 
+func (n none[T]) OkOr(err error) result.Result[T, error] {
+	return result.Err[T](err)
+}
+
+func (n none[T]) OkOrElse(f func() error) result.Result[T, error] {
+	return result.Err[T](f())
+}
+
+func (n none[T]) All() iter.Seq[T] {
+	return func(func(T) bool) {}
+}
+
+func (n none[T]) ToPtr() *T {
+	return nil
+}
+
 func (n none[T]) String() string {
 	return "None"
 }
+
+func (n none[T]) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
@@ -1,11 +1,14 @@
 package option
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/mathieu-lemay/go-sandbox/safetypes/result"
 )
 
 func TestNone_ReturnsNewNoneOption(t *testing.T) {
@@ -17,6 +20,15 @@ func TestNone_ReturnsNewNoneOption(t *testing.T) {
 	assert.Equal(t, none[any]{}, n)
 }
 
+func TestNoneOf_ReturnsNewTypedNoneOption(t *testing.T) {
+	res := NoneOf[int]()
+
+	n, ok := res.(none[int])
+	require.True(t, ok, "result should be a none[int]: %#v", res)
+
+	assert.Equal(t, none[int]{}, n)
+}
+
 func TestNone_IsNone(t *testing.T) {
 	n := None()
 
@@ -200,3 +212,27 @@ func TestNone_String(t *testing.T) {
 
 	assert.Equal(t, "None", n.String())
 }
+
+func TestNone_ToPtr(t *testing.T) {
+	n := NoneOf[int]()
+
+	assert.Nil(t, n.ToPtr())
+}
+
+func TestNone_OkOr(t *testing.T) {
+	n := NoneOf[int]()
+	err := errors.New(fake.RandomStringWithLength(8))
+
+	expected := result.Err[int](err)
+
+	assert.Equal(t, expected, n.OkOr(err))
+	assert.Equal(t, expected, n.OkOrElse(func() error { return err }))
+}
+
+func TestNone_All(t *testing.T) {
+	n := NoneOf[int]()
+
+	for range n.All() {
+		assert.Fail(t, "should not yield any value")
+	}
+}
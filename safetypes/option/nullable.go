@@ -0,0 +1,62 @@
+package option
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Nullable wraps an Option so it can be decoded from JSON, which an interface can't be. It encodes Some as its value
+// and None as null, and decodes null as None and anything else as Some. None is the zero value, so a Nullable field
+// tagged with `omitzero` is omitted from the output when None. It can also be scanned from a nullable column.
+type Nullable[T any] struct {
+	opt Option[T]
+}
+
+// NewNullable wraps the option.
+func NewNullable[T any](opt Option[T]) Nullable[T] {
+	return Nullable[T]{
+		opt: opt,
+	}
+}
+
+// Option returns the wrapped option.
+func (n Nullable[T]) Option() Option[T] {
+	if n.opt == nil {
+		return none[T]{}
+	}
+
+	return n.opt
+}
+
+// IsZero returns `true` if the option is None.
+func (n Nullable[T]) IsZero() bool {
+	return n.Option().IsNone()
+}
+
+// MarshalJSON encodes Some as its value and None as null.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	return n.Option().MarshalJSON()
+}
+
+// UnmarshalJSON decodes null as None and any other value as Some.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		n.opt = none[T]{}
+
+		return nil
+	}
+
+	var val T
+	if err := json.Unmarshal(data, &val); err != nil {
+		return fmt.Errorf("failed to unmarshal option: %w", err)
+	}
+
+	n.opt = Some(val)
+
+	return nil
+}
+
+func (n Nullable[T]) String() string {
+	return n.Option().String()
+}
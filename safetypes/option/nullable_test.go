@@ -0,0 +1,104 @@
+package option
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type payload struct {
+	Name     Nullable[string] `json:"name"`
+	Nickname Nullable[string] `json:"nickname,omitzero"`
+	Age      Nullable[int]    `json:"age"`
+}
+
+func TestNullable_ReturnsTheWrappedOption(t *testing.T) {
+	value := fake.RandomStringWithLength(8)
+
+	assert.Equal(t, Some(value), NewNullable(Some(value)).Option())
+	assert.Equal(t, none[string]{}, NewNullable(NoneOf[string]()).Option())
+}
+
+func TestNullable_ZeroValueIsNone(t *testing.T) {
+	var n Nullable[int]
+
+	assert.Equal(t, none[int]{}, n.Option())
+	assert.True(t, n.IsZero())
+	assert.Equal(t, "None", n.String())
+}
+
+func TestNullable_MarshalJSON(t *testing.T) {
+	t.Run("some", func(t *testing.T) {
+		value := fake.RandomStringWithLength(8)
+		p := payload{
+			Name:     NewNullable(Some(value)),
+			Nickname: NewNullable(Some("nick")),
+			Age:      NewNullable(Some(0)),
+		}
+
+		data, err := json.Marshal(p)
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name": "`+value+`", "nickname": "nick", "age": 0}`, string(data))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		p := payload{
+			Name:     NewNullable(NoneOf[string]()),
+			Nickname: NewNullable(NoneOf[string]()),
+			Age:      Nullable[int]{}, //nolint:exhaustruct  // We want the zero value
+		}
+
+		data, err := json.Marshal(p)
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name": null, "age": null}`, string(data))
+	})
+}
+
+func TestNullable_UnmarshalJSON(t *testing.T) {
+	t.Run("some", func(t *testing.T) {
+		var p payload
+
+		err := json.Unmarshal([]byte(`{"name": "John", "nickname": "", "age": 0}`), &p)
+
+		require.NoError(t, err)
+		assert.Equal(t, Some("John"), p.Name.Option())
+		assert.Equal(t, Some(""), p.Nickname.Option())
+		assert.Equal(t, Some(0), p.Age.Option())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		var p payload
+
+		err := json.Unmarshal([]byte(`{"name": null, "age": null}`), &p)
+
+		require.NoError(t, err)
+		assert.True(t, p.Name.Option().IsNone())
+		assert.True(t, p.Nickname.Option().IsNone())
+		assert.True(t, p.Age.Option().IsNone())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var p payload
+
+		err := json.Unmarshal([]byte(`{"age": "old"}`), &p)
+
+		assert.ErrorContains(t, err, "failed to unmarshal option")
+	})
+}
+
+func TestOption_MarshalJSON(t *testing.T) {
+	value := fake.Int()
+
+	data, err := json.Marshal(struct {
+		A Option[int] `json:"a"`
+		B Option[int] `json:"b"`
+	}{Some(value), NoneOf[int]()})
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"a": `+strconv.Itoa(value)+`, "b": null}`, string(data))
+}
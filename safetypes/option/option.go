@@ -2,8 +2,13 @@
 package option
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"fmt"
+	"iter"
 	"reflect"
+
+	"github.com/mathieu-lemay/go-sandbox/safetypes/result"
 )
 
 // Option is a type that represents either a value (Some) or not (None).
@@ -22,12 +27,32 @@ type Option[T any] interface {
 	Or(other Option[T]) Option[T]
 	OrElse(f func() Option[T]) Option[T]
 	Xor(other Option[T]) Option[T]
+	// OkOr transforms Some(v) into Ok(v) and None into Err(err). Use safetypes.AsOkOr for a specific error type.
+	OkOr(err error) result.Result[T, error]
+	// OkOrElse transforms Some(v) into Ok(v) and None into Err(f()).
+	OkOrElse(f func() error) result.Result[T, error]
+	// All returns an iterator over the value, yielding it once if Some and nothing if None.
+	All() iter.Seq[T]
+	// ToPtr returns a pointer to a copy of the value, or nil if None.
+	ToPtr() *T
 
+	// MarshalJSON encodes Some as its value and None as null. Use Nullable to decode an Option from JSON.
+	json.Marshaler
+	// Value converts Some to its value and None to NULL. Use Nullable to scan an Option from a row.
+	driver.Valuer
 	fmt.Stringer
 }
 
-// Of creates an Option from the given value.
+// Of creates an Option from the given value, treating the zero value as None.
+//
+// Deprecated: Of silently turns valid zero values like 0 or "" into None. Use Some, or FromNonZero when the zero value
+// really means there is no value.
 func Of[T any](val T) Option[T] {
+	return FromNonZero(val)
+}
+
+// FromNonZero creates a Some from the value, or None if it's the zero value of its type.
+func FromNonZero[T any](val T) Option[T] {
 	if reflect.ValueOf(&val).Elem().IsZero() {
 		return none[T]{}
 	}
@@ -35,6 +60,40 @@ func Of[T any](val T) Option[T] {
 	return Some(val)
 }
 
+// FromPtr creates a Some from the value pointed to by ptr, or None if ptr is nil.
+func FromPtr[T any](ptr *T) Option[T] {
+	if ptr == nil {
+		return none[T]{}
+	}
+
+	return Some(*ptr)
+}
+
+// FromOk creates a Some from the value if ok is `true`, or None otherwise. It's meant for comma-ok expressions like
+// map lookups and type assertions.
+func FromOk[T any](val T, ok bool) Option[T] {
+	if !ok {
+		return none[T]{}
+	}
+
+	return Some(val)
+}
+
+// FromNillable creates a Some from the value, or None if it's a nil pointer, slice, map, channel, function or
+// interface. Unlike FromNonZero, other zero values like 0 or "" are Some.
+func FromNillable[T any](val T) Option[T] {
+	v := reflect.ValueOf(&val).Elem()
+
+	switch v.Kind() { //nolint:exhaustive  // Only nillable kinds can be None
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+		if v.IsNil() {
+			return none[T]{}
+		}
+	}
+
+	return Some(val)
+}
+
 // Map maps an Option<T> to Option<U> by applying a function to a contained value (if Some) or returns None (if None).
 func Map[T any, U any](opt Option[T], f func(T) U) Option[U] {
 	s, ok := opt.(some[T])
@@ -85,3 +144,54 @@ func AndThen[T any, U any](opt Option[T], f func(T) Option[U]) Option[U] {
 
 	return f(s.val)
 }
+
+// Pair holds the values of two zipped options.
+type Pair[T any, U any] struct {
+	First  T
+	Second U
+}
+
+// Zip returns Some with a Pair of both values if both options are Some, otherwise returns None.
+func Zip[T any, U any](opt Option[T], other Option[U]) Option[Pair[T, U]] {
+	return ZipWith(opt, other, func(a T, b U) Pair[T, U] {
+		return Pair[T, U]{
+			First:  a,
+			Second: b,
+		}
+	})
+}
+
+// ZipWith returns Some with the result of `f` called with both values if both options are Some, otherwise returns None.
+func ZipWith[T any, U any, R any](opt Option[T], other Option[U], f func(T, U) R) Option[R] {
+	a, ok := opt.(some[T])
+	if !ok {
+		return none[R]{}
+	}
+
+	b, ok := other.(some[U])
+	if !ok {
+		return none[R]{}
+	}
+
+	return Some(f(a.val, b.val))
+}
+
+// Unzip returns both values of the Pair as Some if the option is Some, otherwise returns two None.
+func Unzip[T any, U any](opt Option[Pair[T, U]]) (Option[T], Option[U]) {
+	s, ok := opt.(some[Pair[T, U]])
+	if !ok {
+		return none[T]{}, none[U]{}
+	}
+
+	return Some(s.val.First), Some(s.val.Second)
+}
+
+// Flatten removes one level of nesting from an Option<Option<T>>.
+func Flatten[T any](opt Option[Option[T]]) Option[T] {
+	s, ok := opt.(some[Option[T]])
+	if !ok || s.val == nil {
+		return none[T]{}
+	}
+
+	return s.val
+}
@@ -12,61 +12,105 @@ import (
 
 var fake = faker.NewWithSeed(rand.NewSource(time.Now().UnixNano()))
 
-func TestFrom_ReturnsNewOptionFromArgs(t *testing.T) {
+func TestFromNonZero_ReturnsNoneForZeroValues(t *testing.T) {
 	type S struct {
 		value int
 	}
 
 	t.Run("some", func(t *testing.T) {
-		res1 := Of(fake.IntBetween(1, 100))
+		res1 := FromNonZero(fake.IntBetween(1, 100))
 		assert.True(t, res1.IsSome())
 
-		res2 := Of(fake.Float(2, 1, 100))
+		res2 := FromNonZero(fake.Float(2, 1, 100))
 		assert.True(t, res2.IsSome())
 
-		res3 := Of(fake.RandomStringWithLength(8))
+		res3 := FromNonZero(fake.RandomStringWithLength(8))
 		assert.True(t, res3.IsSome())
 
-		res4 := Of(true)
+		res4 := FromNonZero(true)
 		assert.True(t, res4.IsSome())
 
-		res5 := Of(S{value: 1})
+		res5 := FromNonZero(S{value: 1})
 		assert.True(t, res5.IsSome())
 
 		zeroInt := 0
-		res6 := Of(&zeroInt)
+		res6 := FromNonZero(&zeroInt)
 		assert.True(t, res6.IsSome())
 
 		zeroStr := ""
-		res7 := Of(&zeroStr)
+		res7 := FromNonZero(&zeroStr)
 		assert.True(t, res7.IsSome())
 
 		zeroS := S{} //nolint:exhaustruct  // We want the zero value
-		res8 := Of(&zeroS)
+		res8 := FromNonZero(&zeroS)
 		assert.True(t, res8.IsSome())
 	})
 
 	t.Run("none", func(t *testing.T) {
-		res1 := Of(0)
+		res1 := FromNonZero(0)
 		assert.True(t, res1.IsNone())
 
-		res2 := Of(0.0)
+		res2 := FromNonZero(0.0)
 		assert.True(t, res2.IsNone())
 
-		res3 := Of("")
+		res3 := FromNonZero("")
 		assert.True(t, res3.IsNone())
 
-		res4 := Of(false)
+		res4 := FromNonZero(false)
 		assert.True(t, res4.IsNone())
 
-		res5 := Of(S{}) //nolint:exhaustruct  // We want the zero value
+		res5 := FromNonZero(S{}) //nolint:exhaustruct  // We want the zero value
 		assert.True(t, res5.IsNone())
 
-		res6 := Of((*string)(nil))
+		res6 := FromNonZero((*string)(nil))
 		assert.True(t, res6.IsNone())
 	})
 }
 
+func TestOf_IsFromNonZero(t *testing.T) {
+	v := fake.IntBetween(1, 100)
+
+	assert.Equal(t, Some(v), Of(v))
+	assert.Equal(t, NoneOf[int](), Of(0))
+}
+
+func TestFromPtr_ReturnsTheValuePointedTo(t *testing.T) {
+	zero := 0
+
+	assert.Equal(t, Some(0), FromPtr(&zero))
+	assert.Equal(t, NoneOf[int](), FromPtr[int](nil))
+}
+
+func TestFromOk_ReturnsSomeIfOk(t *testing.T) {
+	m := map[string]int{"zero": 0}
+
+	v, ok := m["zero"]
+	assert.Equal(t, Some(0), FromOk(v, ok))
+
+	v, ok = m["missing"]
+	assert.Equal(t, NoneOf[int](), FromOk(v, ok))
+}
+
+func TestFromNillable_ReturnsNoneForNilValues(t *testing.T) {
+	zero := 0
+
+	t.Run("some", func(t *testing.T) {
+		assert.Equal(t, Some(0), FromNillable(0))
+		assert.Equal(t, Some(""), FromNillable(""))
+		assert.Equal(t, Some(&zero), FromNillable(&zero))
+		assert.Equal(t, Some([]int{}), FromNillable([]int{}))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		assert.True(t, FromNillable((*int)(nil)).IsNone())
+		assert.True(t, FromNillable([]int(nil)).IsNone())
+		assert.True(t, FromNillable(map[string]int(nil)).IsNone())
+		assert.True(t, FromNillable((chan int)(nil)).IsNone())
+		assert.True(t, FromNillable((func())(nil)).IsNone())
+		assert.True(t, FromNillable(error(nil)).IsNone())
+	})
+}
+
 func TestMap_ReturnsANewOptionWithMappedValue(t *testing.T) {
 	t.Run("some", func(t *testing.T) {
 		val := fake.Int()
@@ -195,3 +239,45 @@ func TestAndThen_ReturnsMappedOrNone(t *testing.T) {
 		assert.Equal(t, none[string]{}, AndThen(n, f))
 	})
 }
+
+func TestZip_ReturnsAPairIfBothAreSome(t *testing.T) {
+	a := fake.Int()
+	b := fake.RandomStringWithLength(8)
+
+	assert.Equal(t, Some(Pair[int, string]{First: a, Second: b}), Zip(Some(a), Some(b)))
+	assert.Equal(t, NoneOf[Pair[int, string]](), Zip(Some(a), NoneOf[string]()))
+	assert.Equal(t, NoneOf[Pair[int, string]](), Zip(NoneOf[int](), Some(b)))
+	assert.Equal(t, NoneOf[Pair[int, string]](), Zip(NoneOf[int](), NoneOf[string]()))
+}
+
+func TestZipWith_CombinesTheValuesIfBothAreSome(t *testing.T) {
+	a := fake.Int()
+	b := fake.RandomStringWithLength(8)
+
+	combine := func(a int, b string) string { return strconv.Itoa(a) + b }
+
+	assert.Equal(t, Some(strconv.Itoa(a)+b), ZipWith(Some(a), Some(b), combine))
+	assert.Equal(t, NoneOf[string](), ZipWith(Some(a), NoneOf[string](), combine))
+	assert.Equal(t, NoneOf[string](), ZipWith(NoneOf[int](), Some(b), combine))
+}
+
+func TestUnzip_SplitsThePair(t *testing.T) {
+	a := fake.Int()
+	b := fake.RandomStringWithLength(8)
+
+	first, second := Unzip(Some(Pair[int, string]{First: a, Second: b}))
+	assert.Equal(t, Some(a), first)
+	assert.Equal(t, Some(b), second)
+
+	first, second = Unzip(NoneOf[Pair[int, string]]())
+	assert.Equal(t, NoneOf[int](), first)
+	assert.Equal(t, NoneOf[string](), second)
+}
+
+func TestFlatten_RemovesOneLevelOfNesting(t *testing.T) {
+	value := fake.Int()
+
+	assert.Equal(t, Some(value), Flatten(Some(Some(value))))
+	assert.Equal(t, NoneOf[int](), Flatten(Some(NoneOf[int]())))
+	assert.Equal(t, NoneOf[int](), Flatten(NoneOf[Option[int]]()))
+}
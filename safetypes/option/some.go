@@ -1,6 +1,12 @@
 package option
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"iter"
+
+	"github.com/mathieu-lemay/go-sandbox/safetypes/result"
+)
 
 type some[T any] struct {
 	val T
@@ -79,6 +85,30 @@ func (s some[T]) Xor(other Option[T]) Option[T] {
 	return none[T]{}
 }
 
+func (s some[T]) OkOr(_ error) result.Result[T, error] {
+	return result.Ok[T, error](s.val)
+}
+
+func (s some[T]) OkOrElse(_ func() error) result.Result[T, error] {
+	return result.Ok[T, error](s.val)
+}
+
+func (s some[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		yield(s.val)
+	}
+}
+
+func (s some[T]) ToPtr() *T {
+	val := s.val
+
+	return &val
+}
+
 func (s some[T]) String() string {
 	return fmt.Sprintf("Some(%v)", s.val)
 }
+
+func (s some[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.val)
+}
@@ -1,11 +1,14 @@
 package option
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/mathieu-lemay/go-sandbox/safetypes/result"
 )
 
 func TestSome_ReturnsNewSomeOption(t *testing.T) {
@@ -218,3 +221,43 @@ func TestSome_String(t *testing.T) {
 
 	assert.Equal(t, expected, s.String())
 }
+
+func TestSome_ToPtr(t *testing.T) {
+	value := fake.Int()
+	s := Some(value)
+
+	ptr := s.ToPtr()
+
+	require.NotNil(t, ptr)
+	assert.Equal(t, value, *ptr)
+
+	*ptr++
+
+	assert.Equal(t, value, s.Unwrap(), "modifying the pointer should not modify the option")
+}
+
+func TestSome_OkOr(t *testing.T) {
+	value := fake.Int()
+	s := Some(value)
+
+	expected := result.Ok[int, error](value)
+
+	assert.Equal(t, expected, s.OkOr(errors.New("unused")))
+	assert.Equal(t, expected, s.OkOrElse(func() error {
+		assert.Fail(t, "should not be called")
+
+		return nil
+	}))
+}
+
+func TestSome_All(t *testing.T) {
+	value := fake.Int()
+	s := Some(value)
+
+	var values []int
+	for v := range s.All() {
+		values = append(values, v)
+	}
+
+	assert.Equal(t, []int{value}, values)
+}
@@ -0,0 +1,46 @@
+package option
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+)
+
+// Value converts Some to a driver.Value using the driver's default conversions, so named types and all integer
+// sizes are accepted.
+func (s some[T]) Value() (driver.Value, error) {
+	val, err := driver.DefaultParameterConverter.ConvertValue(s.val)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert option value: %w", err)
+	}
+
+	return val, nil
+}
+
+// Value converts None to NULL.
+func (n none[T]) Value() (driver.Value, error) {
+	return nil, nil //nolint:nilnil  // NULL is represented as a nil value
+}
+
+// Value converts Some to a driver.Value and None to NULL.
+func (n Nullable[T]) Value() (driver.Value, error) {
+	return n.Option().Value()
+}
+
+// Scan scans NULL as None and any other value as Some, with the same conversions as sql.Null.
+func (n *Nullable[T]) Scan(src any) error {
+	var v sql.Null[T]
+	if err := v.Scan(src); err != nil {
+		return fmt.Errorf("failed to scan option: %w", err)
+	}
+
+	if !v.Valid {
+		n.opt = none[T]{}
+
+		return nil
+	}
+
+	n.opt = Some(v.V)
+
+	return nil
+}
@@ -0,0 +1,235 @@
+package option
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubDriver is an in-memory driver whose single table stores the arguments of every INSERT as a row and returns them
+// all on SELECT.
+type stubDriver struct {
+	rows [][]driver.Value
+}
+
+func (d *stubDriver) Open(string) (driver.Conn, error) {
+	return &stubConn{driver: d}, nil
+}
+
+type stubConn struct {
+	driver *stubDriver
+}
+
+func (c *stubConn) Prepare(query string) (driver.Stmt, error) {
+	return &stubStmt{conn: c, query: query}, nil
+}
+
+func (c *stubConn) Close() error {
+	return nil
+}
+
+func (c *stubConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type stubStmt struct {
+	conn  *stubConn
+	query string
+}
+
+func (s *stubStmt) Close() error {
+	return nil
+}
+
+func (s *stubStmt) NumInput() int {
+	return -1
+}
+
+func (s *stubStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.conn.driver.rows = append(s.conn.driver.rows, args)
+
+	return driver.RowsAffected(1), nil
+}
+
+func (s *stubStmt) Query([]driver.Value) (driver.Rows, error) {
+	columns := strings.Split(strings.TrimPrefix(s.query, "SELECT "), ", ")
+
+	return &stubRows{columns: columns, rows: s.conn.driver.rows}, nil
+}
+
+type stubRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *stubRows) Columns() []string {
+	return r.columns
+}
+
+func (r *stubRows) Close() error {
+	return nil
+}
+
+func (r *stubRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+
+	return nil
+}
+
+func openStubDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	d := &stubDriver{}
+	db := sql.OpenDB(stubConnector{driver: d})
+
+	t.Cleanup(func() { _ = db.Close() })
+
+	return db
+}
+
+type stubConnector struct {
+	driver *stubDriver
+}
+
+func (c stubConnector) Connect(_ context.Context) (driver.Conn, error) {
+	return c.driver.Open("")
+}
+
+func (c stubConnector) Driver() driver.Driver {
+	return c.driver
+}
+
+func TestOption_Value(t *testing.T) {
+	type name string
+
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		opt      driver.Valuer
+		expected driver.Value
+	}{
+		{"string", Some("value"), "value"},
+		{"named string", Some(name("value")), "value"},
+		{"int", Some(42), int64(42)},
+		{"uint8", Some(uint8(42)), int64(42)},
+		{"float", Some(float32(1.5)), float64(1.5)},
+		{"bool", Some(true), true},
+		{"time", Some(now), now},
+		{"bytes", Some([]byte("value")), []byte("value")},
+		{"none", none[string]{}, nil},
+		{"nullable", NewNullable(Some(42)), int64(42)},
+		{"zero nullable", Nullable[int]{}, nil}, //nolint:exhaustruct  // We want the zero value
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			val, err := tt.opt.Value()
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, val)
+		})
+	}
+}
+
+func TestOption_ValueReturnsAnErrorForUnsupportedTypes(t *testing.T) {
+	_, err := Some(struct{}{}).Value()
+
+	assert.ErrorContains(t, err, "failed to convert option value")
+}
+
+func TestNullable_Scan(t *testing.T) {
+	t.Run("some", func(t *testing.T) {
+		var s Nullable[string]
+		var i Nullable[int]
+		var f Nullable[float64]
+
+		require.NoError(t, s.Scan([]byte("value")))
+		require.NoError(t, i.Scan("42"))
+		require.NoError(t, f.Scan(int64(2)))
+
+		assert.Equal(t, Some("value"), s.Option())
+		assert.Equal(t, Some(42), i.Option())
+		assert.Equal(t, Some(2.0), f.Option())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		s := NewNullable(Some("value"))
+
+		require.NoError(t, s.Scan(nil))
+
+		assert.Equal(t, none[string]{}, s.Option())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var i Nullable[int]
+
+		err := i.Scan("not a number")
+
+		assert.ErrorContains(t, err, "failed to scan option")
+	})
+}
+
+func TestNullable_RoundTripsThroughTheDatabase(t *testing.T) {
+	db := openStubDB(t)
+
+	now := time.Now().UTC()
+	data := fake.RandomStringWithLength(8)
+
+	_, err := db.Exec("INSERT", Some("value"), Some(int64(42)), Some(1.5), Some(now), Some([]byte(data)))
+	require.NoError(t, err)
+
+	_, err = db.Exec("INSERT", none[string]{}, none[int64]{}, none[float64]{}, none[time.Time]{}, none[[]byte]{})
+	require.NoError(t, err)
+
+	rows, err := db.Query("SELECT s, i, f, t, b")
+	require.NoError(t, err)
+
+	defer func() { _ = rows.Close() }()
+
+	type row struct {
+		s Nullable[string]
+		i Nullable[int64]
+		f Nullable[float64]
+		t Nullable[time.Time]
+		b Nullable[[]byte]
+	}
+
+	var got []row
+
+	for rows.Next() {
+		var r row
+
+		require.NoError(t, rows.Scan(&r.s, &r.i, &r.f, &r.t, &r.b))
+
+		got = append(got, r)
+	}
+
+	require.NoError(t, rows.Err())
+	require.Len(t, got, 2)
+
+	assert.Equal(t, Some("value"), got[0].s.Option())
+	assert.Equal(t, Some(int64(42)), got[0].i.Option())
+	assert.Equal(t, Some(1.5), got[0].f.Option())
+	assert.Equal(t, Some(now), got[0].t.Option())
+	assert.Equal(t, Some([]byte(data)), got[0].b.Option())
+
+	assert.True(t, got[1].s.Option().IsNone())
+	assert.True(t, got[1].i.Option().IsNone())
+	assert.True(t, got[1].f.Option().IsNone())
+	assert.True(t, got[1].t.Option().IsNone())
+	assert.True(t, got[1].b.Option().IsNone())
+}
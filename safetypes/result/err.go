@@ -1,7 +1,10 @@
 // Package result implements https://doc.rust-lang.org/std/result/enum.Result.html
 package result
 
-import "fmt"
+import (
+	"fmt"
+	"iter"
+)
 
 // Err creates an Err variant of Result from the error.
 func Err[T any, E error](err E) Result[T, E] {
@@ -70,6 +73,10 @@ func (e errT[T, E]) UnwrapErr() E {
 	return e.err
 }
 
+func (e errT[T, E]) All() iter.Seq[T] {
+	return func(func(T) bool) {}
+}
+
 func (e errT[T, E]) String() string {
 	return fmt.Sprintf("Err(%v)", e.err)
 }
@@ -178,3 +178,11 @@ func TestErr_String(t *testing.T) {
 	expected := fmt.Sprintf("Err(%v)", err)
 	assert.Equal(t, expected, e.String())
 }
+
+func TestErr_All(t *testing.T) {
+	e := Err[int](fmt.Errorf("some error: %s", fake.RandomStringWithLength(8)))
+
+	for range e.All() {
+		assert.Fail(t, "should not yield any value")
+	}
+}
@@ -0,0 +1,53 @@
+package result
+
+// Match returns the result of `onOk` called with the value if the result is Ok, or of `onErr` called with the error if
+// it's Err.
+func Match[T any, E error, R any](res Result[T, E], onOk func(T) R, onErr func(E) R) R {
+	s, isOk := res.(ok[T, E])
+	if !isOk {
+		return onErr(res.UnwrapErr())
+	}
+
+	return onOk(s.val)
+}
+
+// OkCase is the first step of a Switch, waiting for the Ok handler.
+type OkCase[T any, E error] struct {
+	res Result[T, E]
+}
+
+// ErrCase is the last step of a Switch, waiting for the Err handler.
+type ErrCase[T any, E error] struct {
+	res  Result[T, E]
+	onOk func(T)
+}
+
+// Switch starts an exhaustive switch on the result, for side effects. Nothing runs until both handlers are given:
+//
+//	result.Switch(res).
+//		Ok(func(v T) { ... }).
+//		Err(func(err E) { ... })
+func Switch[T any, E error](res Result[T, E]) OkCase[T, E] {
+	return OkCase[T, E]{
+		res: res,
+	}
+}
+
+// Ok sets the handler called with the value if the result is Ok.
+func (c OkCase[T, E]) Ok(f func(T)) ErrCase[T, E] {
+	return ErrCase[T, E]{
+		res:  c.res,
+		onOk: f,
+	}
+}
+
+// Err sets the handler called with the error if the result is Err, and runs the matching handler.
+func (c ErrCase[T, E]) Err(f func(E)) {
+	if s, isOk := c.res.(ok[T, E]); isOk {
+		c.onOk(s.val)
+
+		return
+	}
+
+	f(c.res.UnwrapErr())
+}
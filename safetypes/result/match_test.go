@@ -0,0 +1,47 @@
+package result
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch_CallsTheMatchingFunction(t *testing.T) {
+	value := fake.Int()
+	onErr := func(err error) string { return err.Error() }
+
+	assert.Equal(t, strconv.Itoa(value), Match(Ok[int, error](value), strconv.Itoa, onErr))
+	assert.Equal(t, "failed", Match(Err[int](errors.New("failed")), strconv.Itoa, onErr))
+}
+
+func TestSwitch_RunsTheMatchingHandler(t *testing.T) {
+	value := fake.Int()
+
+	t.Run("ok", func(t *testing.T) {
+		var got int
+
+		Switch(Ok[int, error](value)).
+			Ok(func(v int) { got = v }).
+			Err(func(error) { assert.Fail(t, "should not be called") })
+
+		assert.Equal(t, value, got)
+	})
+
+	t.Run("err", func(t *testing.T) {
+		err := &MockError{e: fake.RandomStringWithLength(8)}
+
+		var got *MockError
+
+		Switch(Err[int](err)).
+			Ok(func(int) { assert.Fail(t, "should not be called") }).
+			Err(func(e *MockError) { got = e })
+
+		assert.Same(t, err, got)
+	})
+
+	t.Run("incomplete", func(t *testing.T) {
+		Switch(Ok[int, error](value)).Ok(func(int) { assert.Fail(t, "should not be called") })
+	})
+}
@@ -1,7 +1,10 @@
 // Package result implements https://doc.rust-lang.org/std/result/enum.Result.html
 package result
 
-import "fmt"
+import (
+	"fmt"
+	"iter"
+)
 
 // Ok creates an Ok variant of Result from the value.
 func Ok[T any, E error](val T) Result[T, E] {
@@ -68,6 +71,12 @@ func (o ok[T, E]) UnwrapErr() E {
 	panic(fmt.Errorf("called `Result.UnwrapErr()` on an `Ok` value: %v", o.val))
 }
 
+func (o ok[T, E]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		yield(o.val)
+	}
+}
+
 func (o ok[T, E]) String() string {
 	return fmt.Sprintf("Ok(%v)", o.val)
 }
@@ -176,3 +176,15 @@ func TestOk_String(t *testing.T) {
 	expected := fmt.Sprintf("Ok(%v)", value)
 	assert.Equal(t, expected, o.String())
 }
+
+func TestOk_All(t *testing.T) {
+	value := fake.Int()
+	o := Ok[int, error](value)
+
+	var values []int
+	for v := range o.All() {
+		values = append(values, v)
+	}
+
+	assert.Equal(t, []int{value}, values)
+}
@@ -3,6 +3,7 @@ package result
 
 import (
 	"fmt"
+	"iter"
 	"reflect"
 )
 
@@ -25,6 +26,8 @@ type Result[T any, E error] interface {
 	UnwrapOrElse(f func() T) T
 	UnwrapOrDefault() T
 	UnwrapErr() E
+	// All returns an iterator over the value, yielding it once if Ok and nothing if Err.
+	All() iter.Seq[T]
 
 	fmt.Stringer
 }